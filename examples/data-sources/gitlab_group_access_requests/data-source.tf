@@ -0,0 +1,3 @@
+data "gitlab_group_access_requests" "example" {
+  group_id = "12345"
+}
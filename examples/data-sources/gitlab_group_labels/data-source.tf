@@ -0,0 +1,3 @@
+data "gitlab_group_labels" "example" {
+  group = "foo/bar"
+}
@@ -0,0 +1,3 @@
+data "gitlab_group_saml_links" "example" {
+  group = "my/example/group"
+}
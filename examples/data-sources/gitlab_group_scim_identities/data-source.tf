@@ -0,0 +1,3 @@
+data "gitlab_group_scim_identities" "example" {
+  group = "my-top-level-group"
+}
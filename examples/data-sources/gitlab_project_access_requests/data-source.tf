@@ -0,0 +1,3 @@
+data "gitlab_project_access_requests" "example" {
+  project = "12345"
+}
@@ -0,0 +1,4 @@
+data "gitlab_project_export" "example" {
+  project = "my-group/example"
+  file    = "${path.module}/backups/example.tar.gz"
+}
@@ -0,0 +1,9 @@
+data "gitlab_project_labels" "example" {
+  project = "foo/bar"
+}
+
+data "gitlab_project_labels" "bugs" {
+  project                 = "foo/bar"
+  include_ancestor_groups = false
+  search                  = "bug"
+}
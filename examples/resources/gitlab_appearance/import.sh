@@ -0,0 +1,2 @@
+# The appearance of the GitLab instance can be imported using the fixed ID `gitlab`, e.g.
+terraform import gitlab_appearance.this gitlab
@@ -0,0 +1,12 @@
+resource "gitlab_appearance" "this" {
+  title       = "ACME GitLab"
+  description = "Welcome to the GitLab instance of ACME Inc."
+
+  logo      = "${path.module}/images/logo.png"
+  logo_hash = filesha256("${path.module}/images/logo.png")
+
+  header_message           = "This is the staging instance"
+  footer_message           = "Contact the platform team for help"
+  message_background_color = "#E75E40"
+  message_font_color       = "#FFFFFF"
+}
@@ -8,3 +8,11 @@ resource "gitlab_application_settings" "this" {
   require_two_factor_authentication = true
   two_factor_grace_period           = 24
 }
+
+# Only track the configured settings and restore settings removed from the configuration
+resource "gitlab_application_settings" "this" {
+  managed_fields_only     = true
+  restore_original_values = true
+
+  signup_enabled = false
+}
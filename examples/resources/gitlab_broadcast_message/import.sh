@@ -0,0 +1,2 @@
+# You can import a broadcast message using its ID, e.g.
+terraform import gitlab_broadcast_message.maintenance 42
@@ -0,0 +1,8 @@
+resource "gitlab_broadcast_message" "maintenance" {
+  message              = "GitLab will be unavailable on Sunday between 10:00 and 12:00 UTC due to maintenance."
+  starts_at            = "2030-01-01T00:00:00Z"
+  ends_at              = "2030-01-05T12:00:00Z"
+  broadcast_type       = "banner"
+  target_access_levels = ["developer", "maintainer", "owner"]
+  dismissable          = true
+}
@@ -0,0 +1,2 @@
+# GitLab group access request approvals can be imported using an id made up of `group_id:user_id`, e.g.
+terraform import gitlab_group_access_request_approval.example "12345:1337"
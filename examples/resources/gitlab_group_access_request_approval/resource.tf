@@ -0,0 +1,15 @@
+data "gitlab_group_access_requests" "example" {
+  group_id = "12345"
+}
+
+# Approve all pending access requests of users from the company
+resource "gitlab_group_access_request_approval" "example" {
+  for_each = {
+    for r in data.gitlab_group_access_requests.example.access_requests : r.username => r
+    if endswith(r.username, "-acme")
+  }
+
+  group_id     = data.gitlab_group_access_requests.example.group_id
+  user_id      = each.value.user_id
+  access_level = "reporter"
+}
@@ -0,0 +1,26 @@
+resource "gitlab_group" "example" {
+  name = "example"
+  path = "example"
+}
+
+# Protect the `main` branch in all projects of the group
+resource "gitlab_group_branch_protection" "main" {
+  group                        = gitlab_group.example.id
+  branch                       = "main"
+  push_access_level            = "maintainer"
+  merge_access_level           = "developer"
+  unprotect_access_level       = "maintainer"
+  code_owner_approval_required = true
+}
+
+# Protect all `release/*` branches in all projects of the group
+resource "gitlab_group_branch_protection" "release" {
+  group              = gitlab_group.example.id
+  branch             = "release/*"
+  push_access_level  = "no one"
+  merge_access_level = "maintainer"
+
+  allowed_to_merge {
+    group_id = gitlab_group.example.id
+  }
+}
@@ -0,0 +1,2 @@
+# You can import this resource with an id made up of `{group-id}:{epic-board-id}`, e.g.
+terraform import gitlab_group_epic_board.roadmap 42:1
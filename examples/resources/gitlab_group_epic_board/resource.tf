@@ -0,0 +1,31 @@
+resource "gitlab_group" "example" {
+  name        = "example group"
+  path        = "example"
+  description = "An example group"
+}
+
+resource "gitlab_group_label" "planned" {
+  group = gitlab_group.example.id
+  name  = "planned"
+  color = "#0000ff"
+}
+
+resource "gitlab_group_label" "in_progress" {
+  group = gitlab_group.example.id
+  name  = "in progress"
+  color = "#00ff00"
+}
+
+resource "gitlab_group_epic_board" "roadmap" {
+  group            = gitlab_group.example.id
+  name             = "Roadmap"
+  hide_closed_list = true
+
+  lists {
+    label_id = gitlab_group_label.planned.label_id
+  }
+
+  lists {
+    label_id = gitlab_group_label.in_progress.label_id
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab group invitations can be imported using an id made up of `group_id:email`, e.g.
+terraform import gitlab_group_invitation.example "12345:jane.doe@example.com"
@@ -0,0 +1,6 @@
+resource "gitlab_group_invitation" "example" {
+  group_id     = "12345"
+  email        = "jane.doe@example.com"
+  access_level = "developer"
+  expires_at   = "2030-12-31"
+}
@@ -0,0 +1,2 @@
+# You can import this resource with an id made up of `{group-id}:{issue-board-id}`, e.g.
+terraform import gitlab_group_issue_board.kanban 42:1
@@ -0,0 +1,30 @@
+resource "gitlab_group" "example" {
+  name        = "example group"
+  path        = "example"
+  description = "An example group"
+}
+
+resource "gitlab_group_label" "doing" {
+  group = gitlab_group.example.id
+  name  = "doing"
+  color = "#0000ff"
+}
+
+resource "gitlab_group_label" "review" {
+  group = gitlab_group.example.id
+  name  = "review"
+  color = "#00ff00"
+}
+
+resource "gitlab_group_issue_board" "kanban" {
+  group = gitlab_group.example.id
+  name  = "Kanban"
+
+  lists {
+    label_id = gitlab_group_label.doing.label_id
+  }
+
+  lists {
+    label_id = gitlab_group_label.review.label_id
+  }
+}
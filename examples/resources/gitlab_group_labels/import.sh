@@ -0,0 +1,2 @@
+# GitLab group labels can be imported using the group id or path, e.g.
+terraform import gitlab_group_labels.example "12345"
@@ -0,0 +1,2 @@
+# GitLab group LDAP links can be imported using the group id or path, e.g.
+terraform import gitlab_group_ldap_links.example "12345"
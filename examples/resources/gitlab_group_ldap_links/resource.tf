@@ -0,0 +1,15 @@
+resource "gitlab_group_ldap_links" "example" {
+  group = "12345"
+
+  ldap_links {
+    ldap_provider = "ldapmain"
+    cn            = "developers"
+    group_access  = "developer"
+  }
+
+  ldap_links {
+    ldap_provider = "ldapmain"
+    filter        = "(memberOf=cn=maintainers,ou=groups,dc=example,dc=com)"
+    group_access  = "maintainer"
+  }
+}
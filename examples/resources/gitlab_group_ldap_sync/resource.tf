@@ -0,0 +1,18 @@
+resource "gitlab_group_ldap_links" "example" {
+  group = "12345"
+
+  ldap_links {
+    ldap_provider = "ldapmain"
+    cn            = "developers"
+    group_access  = "developer"
+  }
+}
+
+# Sync the group with LDAP whenever its LDAP links change
+resource "gitlab_group_ldap_sync" "example" {
+  group = gitlab_group_ldap_links.example.group
+
+  triggers = {
+    ldap_links = jsonencode(gitlab_group_ldap_links.example.ldap_links)
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab group members can be imported using the group id, e.g.
+terraform import gitlab_group_members.example "12345"
@@ -0,0 +1,26 @@
+resource "gitlab_group_members" "example" {
+  group_id = "12345"
+
+  members {
+    user_id      = 1
+    access_level = "owner"
+  }
+
+  members {
+    user_id      = 1337
+    access_level = "developer"
+    expires_at   = "2030-12-31"
+  }
+}
+
+# Manage only the direct members of a subgroup and keep the access token bots
+resource "gitlab_group_members" "subgroup" {
+  group_id                 = "67890"
+  ignore_inherited_members = true
+  ignore_bot_members       = true
+
+  members {
+    user_id      = 1337
+    access_level = "maintainer"
+  }
+}
@@ -0,0 +1,13 @@
+resource "gitlab_group_saml_links" "example" {
+  group = "12345"
+
+  saml_links {
+    saml_group_name = "developers"
+    access_level    = "developer"
+  }
+
+  saml_links {
+    saml_group_name = "maintainers"
+    access_level    = "maintainer"
+  }
+}
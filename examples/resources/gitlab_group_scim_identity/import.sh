@@ -0,0 +1,2 @@
+# GitLab group SCIM identities can be imported using an id made up of `group:user_id`, e.g.
+terraform import gitlab_group_scim_identity.example "12345:42"
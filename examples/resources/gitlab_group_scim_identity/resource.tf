@@ -0,0 +1,5 @@
+resource "gitlab_group_scim_identity" "example" {
+  group      = "12345"
+  user_id    = 42
+  extern_uid = "00u1abcdefGHIJKLMN0x7"
+}
@@ -0,0 +1,2 @@
+# GitLab group security policy project links can be imported using the group ID or full path, e.g.
+terraform import gitlab_group_security_policy_project.example "my-group"
@@ -0,0 +1,4 @@
+resource "gitlab_group_security_policy_project" "example" {
+  group          = "my-group"
+  policy_project = "my-group/security-policies"
+}
@@ -0,0 +1,2 @@
+# You can import an instance feature using its name, e.g.
+terraform import gitlab_instance_feature.enabled example_feature
@@ -0,0 +1,14 @@
+# Enable a feature for everyone
+resource "gitlab_instance_feature" "enabled" {
+  name    = "example_feature"
+  enabled = true
+}
+
+# Gradually roll out a feature to a subset of users, groups and projects
+resource "gitlab_instance_feature" "rollout" {
+  name                 = "other_example_feature"
+  percentage_of_actors = 25
+  users                = ["root"]
+  groups               = ["my-group"]
+  projects             = ["my-group/my-project"]
+}
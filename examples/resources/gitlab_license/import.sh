@@ -0,0 +1,4 @@
+# You can import a license using its ID, e.g.
+terraform import gitlab_license.this 42
+
+# NOTE: the license key can't be read from the API. The configured `license` is stored in the state on the next apply.
@@ -0,0 +1,6 @@
+resource "gitlab_license" "this" {
+  license = file("${path.module}/company.gitlab-license")
+
+  # Show a warning during planning 60 days before the license expires
+  expiration_warning_days = 60
+}
@@ -0,0 +1,5 @@
+# GitLab member roles can be imported using an id made up of `group_id:member_role_id` for group member roles, e.g.
+terraform import gitlab_member_role.group "12345:42"
+
+# or using the `member_role_id` for instance member roles, e.g.
+terraform import gitlab_member_role.security_reviewer "42"
@@ -0,0 +1,21 @@
+resource "gitlab_member_role" "security_reviewer" {
+  name              = "Security Reviewer"
+  description       = "Reporter, who can also triage vulnerabilities"
+  base_access_level = "reporter"
+  permissions       = ["read_vulnerability", "admin_vulnerability"]
+}
+
+resource "gitlab_group_membership" "example" {
+  group_id       = "12345"
+  user_id        = 1337
+  access_level   = "reporter"
+  member_role_id = gitlab_member_role.security_reviewer.member_role_id
+}
+
+# On GitLab.com member roles are created on top-level groups
+resource "gitlab_member_role" "group" {
+  group_id          = "12345"
+  name              = "Merge Request Admin"
+  base_access_level = "developer"
+  permissions       = ["admin_merge_request"]
+}
@@ -0,0 +1,2 @@
+# You can import the limits of a plan using the plan name, e.g.
+terraform import gitlab_plan_limits.default default
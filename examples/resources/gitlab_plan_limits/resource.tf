@@ -0,0 +1,9 @@
+resource "gitlab_plan_limits" "default" {
+  plan_name = "default"
+
+  ci_pipeline_size              = 500
+  ci_active_jobs                = 1000
+  ci_registered_project_runners = 100
+
+  generic_packages_max_file_size = 5368709120
+}
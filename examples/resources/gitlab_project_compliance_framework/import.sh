@@ -0,0 +1,2 @@
+# Gitlab project compliance framework assignments can be imported with a key composed of `<project>:<compliance_framework_id>`, e.g.
+terraform import gitlab_project_compliance_framework.sample "42:gid://gitlab/ComplianceManagement::Framework/12345"
@@ -0,0 +1,11 @@
+resource "gitlab_compliance_framework" "sample" {
+  namespace_path = "top-level-group"
+  name           = "HIPAA"
+  description    = "A HIPAA Compliance Framework"
+  color          = "#87BEEF"
+}
+
+resource "gitlab_project_compliance_framework" "sample" {
+  project                 = "top-level-group/hipaa-project"
+  compliance_framework_id = gitlab_compliance_framework.sample.framework_id
+}
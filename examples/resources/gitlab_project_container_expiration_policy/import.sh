@@ -0,0 +1,2 @@
+# GitLab project container expiration policies can be imported using the project ID, e.g.
+terraform import gitlab_project_container_expiration_policy.example 12345
@@ -0,0 +1,9 @@
+resource "gitlab_project_container_expiration_policy" "example" {
+  project           = "12345"
+  enabled           = true
+  cadence           = "7d"
+  keep_n            = 10
+  older_than        = "14d"
+  name_regex_delete = ".*"
+  name_regex_keep   = "^(latest|v.+)$"
+}
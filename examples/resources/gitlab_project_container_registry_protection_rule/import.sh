@@ -0,0 +1,2 @@
+# GitLab container registry protection rules can be imported with a key composed of `<project>:<protection_rule_id>`, e.g.
+terraform import gitlab_project_container_registry_protection_rule.latest "12345:1"
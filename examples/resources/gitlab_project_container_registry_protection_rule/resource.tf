@@ -0,0 +1,6 @@
+resource "gitlab_project_container_registry_protection_rule" "latest" {
+  project                         = gitlab_project.example.id
+  repository_path_pattern         = "${gitlab_project.example.path_with_namespace}/*"
+  minimum_access_level_for_push   = "maintainer"
+  minimum_access_level_for_delete = "owner"
+}
@@ -0,0 +1,2 @@
+# The default branch of a project can be imported with the project ID, e.g.
+terraform import gitlab_project_default_branch.main "12345"
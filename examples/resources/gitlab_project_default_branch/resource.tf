@@ -0,0 +1,6 @@
+# Rename the default branch from `master` to `main`, moving its protection
+resource "gitlab_project_default_branch" "main" {
+  project             = "12345"
+  branch              = "main"
+  validate_codeowners = true
+}
@@ -0,0 +1,2 @@
+# GitLab project feature flags can be imported with a key composed of `<project>:<name>`, e.g.
+terraform import gitlab_project_feature_flag.new_checkout "12345:new_checkout"
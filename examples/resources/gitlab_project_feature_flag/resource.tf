@@ -0,0 +1,29 @@
+resource "gitlab_project_environment" "production" {
+  project = gitlab_project.example.id
+  name    = "production"
+}
+
+resource "gitlab_project_feature_flag_user_list" "beta_testers" {
+  project   = gitlab_project.example.id
+  name      = "beta-testers"
+  user_xids = ["user-1", "user-2"]
+}
+
+resource "gitlab_project_feature_flag" "new_checkout" {
+  project     = gitlab_project.example.id
+  name        = "new_checkout"
+  description = "Enables the new checkout flow"
+
+  strategy {
+    name               = "flexibleRollout"
+    percentage         = 25
+    stickiness         = "userId"
+    environment_scopes = [gitlab_project_environment.production.name]
+  }
+
+  strategy {
+    name               = "gitlabUserList"
+    user_list_id       = gitlab_project_feature_flag_user_list.beta_testers.user_list_id
+    environment_scopes = ["*"]
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab project feature flag user lists can be imported with a key composed of `<project>:<iid>`, e.g.
+terraform import gitlab_project_feature_flag_user_list.beta_testers "12345:1"
@@ -0,0 +1,5 @@
+resource "gitlab_project_feature_flag_user_list" "beta_testers" {
+  project   = gitlab_project.example.id
+  name      = "beta-testers"
+  user_xids = ["user-1", "user-2"]
+}
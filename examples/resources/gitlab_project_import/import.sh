@@ -0,0 +1,3 @@
+# GitLab project imports can be imported using the id of the imported project, e.g.
+# NOTE: the `file`, `namespace` and `overwrite` attributes can't be imported.
+terraform import gitlab_project_import.example "12345"
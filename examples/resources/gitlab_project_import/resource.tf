@@ -0,0 +1,5 @@
+resource "gitlab_project_import" "example" {
+  file      = "${path.module}/example.tar.gz"
+  path      = "example"
+  namespace = "my-group"
+}
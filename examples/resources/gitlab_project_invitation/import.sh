@@ -0,0 +1,2 @@
+# GitLab project invitations can be imported using an id made up of `project:email`, e.g.
+terraform import gitlab_project_invitation.example "12345:jane.doe@example.com"
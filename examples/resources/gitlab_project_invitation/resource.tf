@@ -0,0 +1,5 @@
+resource "gitlab_project_invitation" "example" {
+  project      = "12345"
+  email        = "jane.doe@example.com"
+  access_level = "reporter"
+}
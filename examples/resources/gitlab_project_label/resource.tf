@@ -13,3 +13,12 @@ resource "gitlab_project_label" "devops_create" {
   color       = "#ffa500"
 }
 
+
+# Prioritized label, which is promoted to a group label of the parent group
+resource "gitlab_project_label" "critical" {
+  project          = gitlab_project.example.id
+  name             = "critical"
+  color            = "#ff0000"
+  priority         = 1
+  promote_to_group = true
+}
@@ -0,0 +1,2 @@
+# GitLab project labels can be imported using the project id or path, e.g.
+terraform import gitlab_project_labels.example "12345"
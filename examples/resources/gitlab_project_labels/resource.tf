@@ -0,0 +1,15 @@
+resource "gitlab_project_labels" "example" {
+  project = "12345"
+
+  labels {
+    name     = "bug"
+    color    = "#ff0000"
+    priority = 1
+  }
+
+  labels {
+    name        = "feature"
+    color       = "#00ff00"
+    description = "A new feature"
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab project members can be imported using the project id or path, e.g.
+terraform import gitlab_project_members.example "12345"
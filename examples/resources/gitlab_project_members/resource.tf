@@ -0,0 +1,15 @@
+resource "gitlab_project_members" "example" {
+  project                  = "12345"
+  ignore_inherited_members = true
+
+  members {
+    user_id      = 1337
+    access_level = "maintainer"
+  }
+
+  members {
+    user_id      = 1338
+    access_level = "reporter"
+    expires_at   = "2030-12-31"
+  }
+}
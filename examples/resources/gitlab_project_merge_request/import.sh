@@ -0,0 +1,4 @@
+# GitLab merge requests can be imported with a key composed of `<project_id>:<merge_request_iid>`, e.g.
+terraform import gitlab_project_merge_request.codeowners "12345:1"
+
+# NOTE: the `files` and `commit_message` attributes are not imported.
@@ -0,0 +1,40 @@
+resource "gitlab_project_merge_request" "codeowners" {
+  project        = "12345"
+  source_branch  = "update-codeowners"
+  title          = "Update CODEOWNERS"
+  description    = "Generated by Terraform."
+  labels         = ["automation"]
+  reviewer_ids   = [42]
+  commit_message = "Update CODEOWNERS"
+
+  files {
+    file_path = "CODEOWNERS"
+    content   = <<-EOT
+      * @platform-team
+      /docs/ @docs-team
+    EOT
+  }
+
+  merge_when_pipeline_succeeds = true
+  remove_source_branch         = true
+}
+
+# Wait for the merge request to be merged before dependent resources are created
+resource "gitlab_project_merge_request" "ci_config" {
+  project        = "12345"
+  source_branch  = "bootstrap-ci"
+  title          = "Bootstrap CI configuration"
+  commit_message = "Add .gitlab-ci.yml"
+
+  files {
+    file_path = ".gitlab-ci.yml"
+    content   = file("${path.module}/gitlab-ci.yml")
+  }
+
+  merge_when_pipeline_succeeds = true
+  wait_for_merge               = true
+
+  timeouts {
+    create = "30m"
+  }
+}
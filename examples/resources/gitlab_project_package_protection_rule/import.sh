@@ -0,0 +1,2 @@
+# GitLab package protection rules can be imported with a key composed of `<project>:<protection_rule_id>`, e.g.
+terraform import gitlab_project_package_protection_rule.release "12345:1"
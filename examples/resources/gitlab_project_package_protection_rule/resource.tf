@@ -0,0 +1,6 @@
+resource "gitlab_project_package_protection_rule" "release" {
+  project                       = gitlab_project.example.id
+  package_name_pattern          = "@my-scope/my-package-*"
+  package_type                  = "npm"
+  minimum_access_level_for_push = "maintainer"
+}
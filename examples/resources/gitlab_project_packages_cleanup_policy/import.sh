@@ -0,0 +1,2 @@
+# GitLab project packages cleanup policies can be imported using the project ID, e.g.
+terraform import gitlab_project_packages_cleanup_policy.example 12345
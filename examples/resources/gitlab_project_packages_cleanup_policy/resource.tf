@@ -0,0 +1,4 @@
+resource "gitlab_project_packages_cleanup_policy" "example" {
+  project                         = "12345"
+  keep_n_duplicated_package_files = "10"
+}
@@ -0,0 +1,3 @@
+# GitLab project pull mirrors can be imported using the project id, e.g.
+# NOTE: the `auth_user` and `auth_password` attributes can't be imported.
+terraform import gitlab_project_pull_mirror.example "12345"
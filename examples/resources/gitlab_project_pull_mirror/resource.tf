@@ -0,0 +1,14 @@
+resource "gitlab_project_pull_mirror" "example" {
+  project       = "12345"
+  url           = "https://github.com/example/example.git"
+  auth_user     = "example"
+  auth_password = var.github_token
+
+  mirror_trigger_builds = true
+  mirror_branch_regex   = "^(main|release/.*)$"
+
+  # Change the value to trigger an update of the pull mirror
+  sync_triggers = {
+    sync = "1"
+  }
+}
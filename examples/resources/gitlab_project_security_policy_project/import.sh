@@ -0,0 +1,2 @@
+# GitLab project security policy project links can be imported using the project ID or full path, e.g.
+terraform import gitlab_project_security_policy_project.example "my-group/my-project"
@@ -0,0 +1,4 @@
+resource "gitlab_project_security_policy_project" "example" {
+  project        = "my-group/my-project"
+  policy_project = "my-group/security-policies"
+}
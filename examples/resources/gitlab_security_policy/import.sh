@@ -0,0 +1,2 @@
+# GitLab security policies can be imported with a key composed of `<project>:<branch>`, e.g.
+terraform import gitlab_security_policy.example "my-group/security-policies:main"
@@ -0,0 +1,41 @@
+resource "gitlab_security_policy" "example" {
+  project = "my-group/security-policies"
+
+  scan_execution_policy {
+    name        = "Enforce secret detection"
+    description = "Run secret detection in every pipeline on protected branches"
+
+    rules {
+      type        = "pipeline"
+      branch_type = "protected"
+    }
+
+    actions {
+      scan = "secret_detection"
+    }
+  }
+
+  scan_result_policy {
+    name = "Require approval for critical vulnerabilities"
+
+    rules {
+      type                    = "scan_finding"
+      branch_type             = "default"
+      scanners                = ["container_scanning", "dependency_scanning"]
+      vulnerabilities_allowed = 0
+      severity_levels         = ["critical"]
+      vulnerability_states    = ["newly_detected"]
+    }
+
+    actions {
+      type               = "require_approval"
+      approvals_required = 1
+      group_approvers    = ["my-group/security-team"]
+    }
+  }
+}
+
+resource "gitlab_group_security_policy_project" "example" {
+  group          = "my-group"
+  policy_project = gitlab_security_policy.example.project
+}
@@ -9,3 +9,13 @@ resource "gitlab_user" "example" {
   is_external      = true
   reset_password   = false
 }
+
+# Offboard a leaver: block the user and delete all their contributions once the resource is destroyed
+resource "gitlab_user" "leaver" {
+  name        = "Leaver"
+  username    = "leaver"
+  email       = "leaver@user.create"
+  password    = "superPassword"
+  state       = "blocked"
+  hard_delete = true
+}
@@ -0,0 +1,4 @@
+# You can import a user email using an id made up of `<user-id>:<email-id>`, e.g.
+terraform import gitlab_user_email.secondary 42:1
+
+# NOTE: the `skip_confirmation` resource attribute is not available for imported resources as this information cannot be read from the GitLab API.
@@ -0,0 +1,11 @@
+resource "gitlab_user" "example" {
+  name     = "Example User"
+  username = "example"
+  email    = "example@example.com"
+}
+
+resource "gitlab_user_email" "secondary" {
+  user_id           = gitlab_user.example.id
+  email             = "example.secondary@example.com"
+  skip_confirmation = true
+}
@@ -0,0 +1,2 @@
+# You can import a user identity using an id made up of `<user-id>:<identity-provider>`, e.g.
+terraform import gitlab_user_identity.saml 42:saml
@@ -0,0 +1,19 @@
+resource "gitlab_user" "example" {
+  name     = "Example User"
+  username = "example"
+  email    = "example@example.com"
+}
+
+# Map the user to its account at the SAML identity provider
+resource "gitlab_user_identity" "saml" {
+  user_id           = gitlab_user.example.id
+  identity_provider = "saml"
+  extern_uid        = "example@corp.example.com"
+}
+
+# Map the user to its LDAP entry
+resource "gitlab_user_identity" "ldap" {
+  user_id           = gitlab_user.example.id
+  identity_provider = "ldapmain"
+  extern_uid        = "uid=example,ou=people,dc=example,dc=com"
+}
@@ -0,0 +1,13 @@
+resource "gitlab_user_impersonation_token" "example" {
+  user_id    = "25"
+  name       = "Example impersonation token"
+  expires_at = "2030-03-14"
+
+  scopes = ["api"]
+}
+
+resource "gitlab_project_variable" "example" {
+  project = gitlab_project.example.id
+  key     = "impersonation_token"
+  value   = gitlab_user_impersonation_token.example.token
+}
@@ -0,0 +1,4 @@
+# You can import a user status using the ID of the user, e.g.
+terraform import gitlab_user_status.example 42
+
+# NOTE: the `clear_status_after` resource attribute is not available for imported resources as this information cannot be read from the GitLab API.
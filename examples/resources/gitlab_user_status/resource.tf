@@ -0,0 +1,14 @@
+# Set the status of the current user
+resource "gitlab_user_status" "current" {
+  emoji              = "palm_tree"
+  message            = "On vacation"
+  availability       = "busy"
+  clear_status_after = "7_days"
+}
+
+# Set the status of another user, requires admin privileges
+resource "gitlab_user_status" "example" {
+  user_id = 42
+  emoji   = "coffee"
+  message = "On a break"
+}
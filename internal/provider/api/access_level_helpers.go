@@ -61,6 +61,16 @@ var ValidProtectedEnvironmentDeploymentLevelNames = []string{
 	"developer", "maintainer",
 }
 
+// The minimum access levels which can be required by container registry and package protection rules.
+// Unlike other endpoints, these are passed to the API by name and not by their int id.
+var ValidRegistryProtectionRuleAccessLevelNames = []string{
+	"maintainer", "owner", "admin",
+}
+
+var ValidBroadcastMessageTargetAccessLevelNames = []string{
+	"guest", "reporter", "developer", "maintainer", "owner",
+}
+
 var ValidProjectEnvironmentStates = []string{
 	"available", "stopped",
 }
@@ -0,0 +1,8 @@
+package api
+
+// The values accepted by the GitLab API for the container expiration policy of a project.
+// These are shared by the `container_expiration_policy` block of the `gitlab_project` resource
+// and the standalone `gitlab_project_container_expiration_policy` resource.
+var ValidContainerExpirationPolicyCadenceValues = []string{
+	"1d", "7d", "14d", "1month", "3month",
+}
@@ -0,0 +1,26 @@
+package api
+
+import "context"
+
+// Lock can be used to lock, but make it `context.Context` aware.
+// e.g. it'll respect cancelling and timeouts.
+type Lock chan struct{}
+
+func NewLock() Lock {
+	return make(Lock, 1)
+}
+
+func (c Lock) Lock(ctx context.Context) error {
+	select {
+	case c <- struct{}{}:
+		// lock acquired
+		return nil
+	case <-ctx.Done():
+		// Timeout
+		return ctx.Err()
+	}
+}
+
+func (c Lock) Unlock() {
+	<-c
+}
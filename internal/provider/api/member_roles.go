@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The base access levels a custom member role can be built on.
+var ValidMemberRoleBaseAccessLevelNames = []string{
+	"minimal", "guest", "reporter", "developer", "maintainer",
+}
+
+// The permissions which can be granted on top of the base access level of a custom member role.
+// see https://docs.gitlab.com/ee/user/custom_roles/abilities.html
+var ValidMemberRolePermissions = []string{
+	"admin_cicd_variables",
+	"admin_compliance_framework",
+	"admin_group_member",
+	"admin_merge_request",
+	"admin_push_rules",
+	"admin_runners",
+	"admin_terraform_state",
+	"admin_vulnerability",
+	"admin_web_hook",
+	"archive_project",
+	"manage_deploy_tokens",
+	"manage_group_access_tokens",
+	"manage_merge_request_settings",
+	"manage_project_access_tokens",
+	"manage_security_policy_link",
+	"read_code",
+	"read_crm_contact",
+	"read_dependency",
+	"read_runners",
+	"read_vulnerability",
+	"remove_group",
+	"remove_project",
+}
+
+// MemberRole is a custom member role. The permissions are returned as boolean attributes
+// and are collected into the `Permissions` map when listing the member roles.
+//
+// NOTE: go-gitlab doesn't support member roles yet.
+type MemberRole struct {
+	ID              int                     `json:"id"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	GroupID         *int                    `json:"group_id"`
+	BaseAccessLevel gitlab.AccessLevelValue `json:"base_access_level"`
+	Permissions     map[string]bool         `json:"-"`
+}
+
+// MemberRolesPath returns the API path of the member roles of the given group,
+// or of the instance-level member roles if the group is empty.
+func MemberRolesPath(group string) string {
+	if group == "" {
+		return "member_roles"
+	}
+	return fmt.Sprintf("groups/%s/member_roles", gitlab.PathEscape(group))
+}
+
+// ListMemberRoles lists the custom member roles of a group or of the instance, if the group is empty.
+func ListMemberRoles(ctx context.Context, client *gitlab.Client, group string) ([]*MemberRole, error) {
+	req, err := client.NewRequest(http.MethodGet, MemberRolesPath(group), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []map[string]interface{}
+	if _, err := client.Do(req, &raw); err != nil {
+		return nil, err
+	}
+
+	var memberRoles []*MemberRole
+	for _, r := range raw {
+		memberRole := &MemberRole{Permissions: map[string]bool{}}
+		if v, ok := r["id"].(float64); ok {
+			memberRole.ID = int(v)
+		}
+		if v, ok := r["name"].(string); ok {
+			memberRole.Name = v
+		}
+		if v, ok := r["description"].(string); ok {
+			memberRole.Description = v
+		}
+		if v, ok := r["group_id"].(float64); ok {
+			groupID := int(v)
+			memberRole.GroupID = &groupID
+		}
+		if v, ok := r["base_access_level"].(float64); ok {
+			memberRole.BaseAccessLevel = gitlab.AccessLevelValue(v)
+		}
+		for _, permission := range ValidMemberRolePermissions {
+			if v, ok := r[permission].(bool); ok && v {
+				memberRole.Permissions[permission] = true
+			}
+		}
+		memberRoles = append(memberRoles, memberRole)
+	}
+	return memberRoles, nil
+}
+
+// MemberRoleID is the custom member role of a member, as returned by the members API.
+type MemberRoleID struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/xanzy/go-gitlab"
+)
+
+// NOTE: this lock is a bit of a hack to prevent parallel calls to the GitLab Repository Files API.
+//
+//	If it is called concurrently, the API will return a 400 error along the lines of:
+//	```
+//	(400 Bad Request) DELETE https://gitlab.com/api/v4/projects/30716/repository/files/somefile.yaml: 400
+//	{message: 9:Could not update refs/heads/master. Please refresh and try again..}
+//	```
+//
+//	This lock only solves half of the problem, where the provider is responsible for
+//	the concurrency. The other half is if the API is called outside of terraform at the same time
+//	this resource makes calls to the API.
+//	To mitigate this, simple retries are used.
+//
+//	The lock is shared between all resources committing to a repository, no matter if they
+//	are implemented with the SDK or the Framework.
+var RepositoryFilesApiLock = NewLock()
+
+// IsRefreshError returns true if the error is the GitLab error for a concurrent change to the same ref.
+func IsRefreshError(err error) bool {
+	var httpErr *gitlab.ErrorResponse
+	return errors.As(err, &httpErr) &&
+		httpErr.Response.StatusCode == http.StatusBadRequest &&
+		strings.Contains(httpErr.Message, "Please refresh and try again")
+}
+
+// RepositoryFileCommitOptions are the commit details used by the repository file helpers in this file.
+type RepositoryFileCommitOptions struct {
+	Branch string
+	// StartBranch is the branch to create `Branch` from if it doesn't exist yet.
+	StartBranch   string
+	CommitMessage string
+	AuthorEmail   string
+	AuthorName    string
+	// Encoding of the given content, either `text` or `base64`. Defaults to `text`.
+	Encoding string
+	// ExecuteFilemode enables or disables the execute flag on the file, if set.
+	ExecuteFilemode *bool
+	Timeout         time.Duration
+}
+
+// GetRepositoryFileContent returns the decoded content of the file at the given ref.
+func GetRepositoryFileContent(ctx context.Context, client *gitlab.Client, project string, filePath string, ref string) (string, *gitlab.File, error) {
+	file, _, err := client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{Ref: gitlab.String(ref)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", nil, err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to decode content of file %q: %w", filePath, err)
+	}
+	return string(content), file, nil
+}
+
+// CreateRepositoryFile creates the file with the given content. It fails if the file already exists.
+func CreateRepositoryFile(ctx context.Context, client *gitlab.Client, project string, filePath string, content string, options *RepositoryFileCommitOptions) error {
+	return commitRepositoryFile(ctx, client, project, filePath, content, options, true, false)
+}
+
+// UpdateRepositoryFile updates the content of the file. It fails if the file doesn't exist.
+func UpdateRepositoryFile(ctx context.Context, client *gitlab.Client, project string, filePath string, content string, options *RepositoryFileCommitOptions) error {
+	return commitRepositoryFile(ctx, client, project, filePath, content, options, false, true)
+}
+
+// CommitRepositoryFile commits the given content to the file,
+// creating it if it does not exist yet or updating it otherwise.
+func CommitRepositoryFile(ctx context.Context, client *gitlab.Client, project string, filePath string, content string, options *RepositoryFileCommitOptions) error {
+	return commitRepositoryFile(ctx, client, project, filePath, content, options, true, true)
+}
+
+func commitRepositoryFile(ctx context.Context, client *gitlab.Client, project string, filePath string, content string, options *RepositoryFileCommitOptions, allowCreate bool, allowUpdate bool) error {
+	if err := RepositoryFilesApiLock.Lock(ctx); err != nil {
+		return err
+	}
+	defer RepositoryFilesApiLock.Unlock()
+
+	encoding := options.Encoding
+	if encoding == "" {
+		encoding = "text"
+	}
+
+	return retry.RetryContext(ctx, options.Timeout, func() *retry.RetryError {
+		var existingFile *gitlab.File
+		if allowUpdate {
+			// NOTE: we re-read the file on every attempt to obtain an eventually changed `LastCommitID`
+			var err error
+			existingFile, _, err = client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{Ref: gitlab.String(options.Branch)}, gitlab.WithContext(ctx))
+			if err != nil && (!Is404(err) || !allowCreate) {
+				return refreshRetryError(err)
+			}
+		}
+
+		var err error
+		if existingFile == nil {
+			_, _, err = client.RepositoryFiles.CreateFile(project, filePath, &gitlab.CreateFileOptions{
+				Branch:          gitlab.String(options.Branch),
+				StartBranch:     optionalString(options.StartBranch),
+				Encoding:        gitlab.String(encoding),
+				ExecuteFilemode: options.ExecuteFilemode,
+				AuthorEmail:     optionalString(options.AuthorEmail),
+				AuthorName:      optionalString(options.AuthorName),
+				Content:         gitlab.String(content),
+				CommitMessage:   gitlab.String(options.CommitMessage),
+			}, gitlab.WithContext(ctx))
+		} else {
+			_, _, err = client.RepositoryFiles.UpdateFile(project, filePath, &gitlab.UpdateFileOptions{
+				Branch:          gitlab.String(options.Branch),
+				StartBranch:     optionalString(options.StartBranch),
+				Encoding:        gitlab.String(encoding),
+				ExecuteFilemode: options.ExecuteFilemode,
+				AuthorEmail:     optionalString(options.AuthorEmail),
+				AuthorName:      optionalString(options.AuthorName),
+				Content:         gitlab.String(content),
+				CommitMessage:   gitlab.String(options.CommitMessage),
+				LastCommitID:    gitlab.String(existingFile.LastCommitID),
+			}, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return refreshRetryError(err)
+		}
+		return nil
+	})
+}
+
+// CommitRepositoryFiles commits the given plain text contents, keyed by file path, with CommitRepositoryFile.
+// Files which already have the given content are skipped, so a commit is only created for each changed file.
+// If the branch doesn't exist yet, it is created from `StartBranch` first.
+func CommitRepositoryFiles(ctx context.Context, client *gitlab.Client, project string, files map[string]string, options *RepositoryFileCommitOptions) error {
+	if _, _, err := client.Branches.GetBranch(project, options.Branch, gitlab.WithContext(ctx)); err != nil {
+		if !Is404(err) || options.StartBranch == "" {
+			return err
+		}
+		if _, _, err := client.Branches.CreateBranch(project, &gitlab.CreateBranchOptions{
+			Branch: gitlab.String(options.Branch),
+			Ref:    gitlab.String(options.StartBranch),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	filePaths := make([]string, 0, len(files))
+	for filePath := range files {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	fileOptions := *options
+	fileOptions.StartBranch = ""
+	for _, filePath := range filePaths {
+		content := files[filePath]
+
+		existingContent, _, err := GetRepositoryFileContent(ctx, client, project, filePath, options.Branch)
+		if err != nil && !Is404(err) {
+			return err
+		}
+		if err == nil && existingContent == content {
+			continue
+		}
+
+		if err := CommitRepositoryFile(ctx, client, project, filePath, content, &fileOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteRepositoryFile deletes the file from the repository. A file which doesn't exist is not considered an error.
+func DeleteRepositoryFile(ctx context.Context, client *gitlab.Client, project string, filePath string, options *RepositoryFileCommitOptions) error {
+	if err := RepositoryFilesApiLock.Lock(ctx); err != nil {
+		return err
+	}
+	defer RepositoryFilesApiLock.Unlock()
+
+	return retry.RetryContext(ctx, options.Timeout, func() *retry.RetryError {
+		existingFile, _, err := client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{Ref: gitlab.String(options.Branch)}, gitlab.WithContext(ctx))
+		if err != nil {
+			if Is404(err) {
+				return nil
+			}
+			return refreshRetryError(err)
+		}
+
+		_, err = client.RepositoryFiles.DeleteFile(project, filePath, &gitlab.DeleteFileOptions{
+			Branch:        gitlab.String(options.Branch),
+			AuthorEmail:   optionalString(options.AuthorEmail),
+			AuthorName:    optionalString(options.AuthorName),
+			CommitMessage: gitlab.String(options.CommitMessage),
+			LastCommitID:  gitlab.String(existingFile.LastCommitID),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return refreshRetryError(err)
+		}
+		return nil
+	})
+}
+
+// refreshRetryError marks the error as retryable if GitLab asks to refresh because of a concurrent change.
+func refreshRetryError(err error) *retry.RetryError {
+	if IsRefreshError(err) {
+		return retry.RetryableError(err)
+	}
+	return retry.NonRetryableError(err)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return gitlab.String(s)
+}
@@ -63,3 +63,18 @@ func parseVersionMajorMinor(version string) (int, int, error) {
 
 	return major, minor, nil
 }
+
+// IsGitLabEnterprise checks if the GitLab instance is running the Enterprise Edition.
+func IsGitLabEnterprise(ctx context.Context, client *gitlab.Client) (bool, error) {
+	metadata, _, err := client.Metadata.GetMetadata(gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	return IsEnterpriseMetadata(metadata), nil
+}
+
+// IsEnterpriseMetadata checks if the given metadata belongs to a GitLab instance running the Enterprise Edition.
+func IsEnterpriseMetadata(metadata *gitlab.Metadata) bool {
+	// GitLab 15.5 doesn't return the `enterprise` field yet, but has a `-ee` version suffix.
+	return metadata.Enterprise || strings.Contains(metadata.Version, "-ee")
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabBroadcastMessageResource{}
+	_ resource.ResourceWithConfigure   = &gitlabBroadcastMessageResource{}
+	_ resource.ResourceWithImportState = &gitlabBroadcastMessageResource{}
+)
+
+func init() {
+	registerResource(NewGitLabBroadcastMessageResource)
+}
+
+func NewGitLabBroadcastMessageResource() resource.Resource {
+	return &gitlabBroadcastMessageResource{}
+}
+
+var validBroadcastMessageTypes = []string{"banner", "notification"}
+
+type gitlabBroadcastMessageResource struct {
+	client *gitlab.Client
+}
+
+type gitlabBroadcastMessageResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	Message            types.String   `tfsdk:"message"`
+	StartsAt           types.String   `tfsdk:"starts_at"`
+	EndsAt             types.String   `tfsdk:"ends_at"`
+	BroadcastType      types.String   `tfsdk:"broadcast_type"`
+	TargetPath         types.String   `tfsdk:"target_path"`
+	TargetAccessLevels []types.String `tfsdk:"target_access_levels"`
+	Dismissable        types.Bool     `tfsdk:"dismissable"`
+	Active             types.Bool     `tfsdk:"active"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabBroadcastMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_broadcast_message"
+}
+
+func (r *gitlabBroadcastMessageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_broadcast_message`" + ` resource allows to manage the lifecycle of a broadcast message, which is shown as a banner or notification to the users of the instance.
+
+-> Requires administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/broadcast_messages.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the broadcast message.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The message to display. Supports markdown.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "The time the message is shown from, in the RFC3339 format. Defaults to the time of creation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{utils.RFC3339Validator},
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "The time the message is shown until, in the RFC3339 format. Defaults to one hour after the time of creation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{utils.RFC3339Validator},
+			},
+			"broadcast_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The appearance of the message. Valid values are: %s. Defaults to `banner`.", utils.RenderValueListForDocs(validBroadcastMessageTypes)),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("banner"),
+				Validators:          []validator.String{stringvalidator.OneOf(validBroadcastMessageTypes...)},
+			},
+			"target_path": schema.StringAttribute{
+				MarkdownDescription: "The path the message is shown on, e.g. `*/my-project/*`. Supports the `*` wildcard. If not set, the message is shown on all pages.",
+				Optional:            true,
+			},
+			"target_access_levels": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("The access levels of the users to show the message to, in any group or project. Valid values are: %s. If not set, the message is shown to all users.", utils.RenderValueListForDocs(api.ValidBroadcastMessageTargetAccessLevelNames)),
+				ElementType:         types.StringType,
+				Optional:            true,
+				// go-gitlab omits an empty list, therefore the message must be re-created to remove the target access levels.
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplaceIf(
+					func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+					},
+					"Removing the target access levels requires re-creating the broadcast message.",
+					"Removing the target access levels requires re-creating the broadcast message.",
+				)},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(api.ValidBroadcastMessageTargetAccessLevelNames...)),
+				},
+			},
+			"dismissable": schema.BoolAttribute{
+				MarkdownDescription: "Whether users can dismiss the message. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the message is currently shown, i.e. the current time is between `starts_at` and `ends_at`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabBroadcastMessageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new upstream resource and adds it into the Terraform state.
+func (r *gitlabBroadcastMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabBroadcastMessageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := &gitlab.CreateBroadcastMessageOptions{
+		Message:            gitlab.String(data.Message.ValueString()),
+		BroadcastType:      gitlab.String(data.BroadcastType.ValueString()),
+		TargetPath:         data.TargetPath.ValueStringPointer(),
+		TargetAccessLevels: data.targetAccessLevels(),
+		Dismissable:        gitlab.Bool(data.Dismissable.ValueBool()),
+	}
+	startsAt, endsAt, err := data.timestamps()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid timestamp", err.Error())
+		return
+	}
+	options.StartsAt = startsAt
+	options.EndsAt = endsAt
+
+	message, _, err := r.client.BroadcastMessage.CreateBroadcastMessage(options, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create broadcast message: %s", err.Error()))
+		return
+	}
+
+	data.Id = types.StringValue(strconv.Itoa(message.ID))
+	data.broadcastMessageToStateModel(message)
+
+	tflog.Debug(ctx, "created a broadcast message", map[string]interface{}{
+		"id": message.ID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabBroadcastMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabBroadcastMessageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid resource ID format", fmt.Sprintf("The resource ID '%s' has an invalid format. It should be the numeric ID of the broadcast message. Error: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	message, _, err := r.client.BroadcastMessage.GetBroadcastMessage(id, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "broadcast message does not exist, removing from state", map[string]interface{}{
+				"id": id,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read broadcast message: %s", err.Error()))
+		return
+	}
+
+	data.broadcastMessageToStateModel(message)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabBroadcastMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabBroadcastMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid resource ID format", fmt.Sprintf("The resource ID '%s' has an invalid format. It should be the numeric ID of the broadcast message. Error: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	options := &gitlab.UpdateBroadcastMessageOptions{
+		Message:            gitlab.String(data.Message.ValueString()),
+		BroadcastType:      gitlab.String(data.BroadcastType.ValueString()),
+		TargetPath:         gitlab.String(data.TargetPath.ValueString()),
+		TargetAccessLevels: data.targetAccessLevels(),
+		Dismissable:        gitlab.Bool(data.Dismissable.ValueBool()),
+	}
+	startsAt, endsAt, err := data.timestamps()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid timestamp", err.Error())
+		return
+	}
+	options.StartsAt = startsAt
+	options.EndsAt = endsAt
+
+	message, _, err := r.client.BroadcastMessage.UpdateBroadcastMessage(id, options, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update broadcast message: %s", err.Error()))
+		return
+	}
+
+	data.broadcastMessageToStateModel(message)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabBroadcastMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabBroadcastMessageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid resource ID format", fmt.Sprintf("The resource ID '%s' has an invalid format. It should be the numeric ID of the broadcast message. Error: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	if _, err := r.client.BroadcastMessage.DeleteBroadcastMessage(id, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete broadcast message: %s", err.Error()))
+	}
+}
+
+func (r *gitlabBroadcastMessageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (data *gitlabBroadcastMessageResourceModel) targetAccessLevels() []gitlab.AccessLevelValue {
+	var levels []gitlab.AccessLevelValue
+	for _, level := range data.TargetAccessLevels {
+		levels = append(levels, api.AccessLevelNameToValue[level.ValueString()])
+	}
+	return levels
+}
+
+// timestamps returns the configured start and end time. Unknown values are left to the GitLab defaults.
+func (data *gitlabBroadcastMessageResourceModel) timestamps() (*time.Time, *time.Time, error) {
+	var startsAt, endsAt *time.Time
+	if !data.StartsAt.IsUnknown() && !data.StartsAt.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse `starts_at`: %w", err)
+		}
+		startsAt = &t
+	}
+	if !data.EndsAt.IsUnknown() && !data.EndsAt.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse `ends_at`: %w", err)
+		}
+		endsAt = &t
+	}
+	return startsAt, endsAt, nil
+}
+
+func (data *gitlabBroadcastMessageResourceModel) broadcastMessageToStateModel(message *gitlab.BroadcastMessage) {
+	data.Message = types.StringValue(message.Message)
+	data.StartsAt = equivalentTimestampValue(data.StartsAt, message.StartsAt)
+	data.EndsAt = equivalentTimestampValue(data.EndsAt, message.EndsAt)
+	data.BroadcastType = types.StringValue(message.BroadcastType)
+	data.Dismissable = types.BoolValue(message.Dismissable)
+	data.Active = types.BoolValue(message.Active)
+
+	data.TargetPath = types.StringNull()
+	if message.TargetPath != "" {
+		data.TargetPath = types.StringValue(message.TargetPath)
+	}
+
+	data.TargetAccessLevels = nil
+	for _, level := range message.TargetAccessLevels {
+		data.TargetAccessLevels = append(data.TargetAccessLevels, types.StringValue(api.AccessLevelValueToName[level]))
+	}
+}
+
+// equivalentTimestampValue returns the prior value if it represents the same instant as the timestamp returned by the API,
+// in order to keep the configured time zone and precision. Otherwise the timestamp is formatted in RFC3339.
+func equivalentTimestampValue(prior types.String, timestamp *time.Time) types.String {
+	if timestamp == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if t, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && t.Equal(*timestamp) {
+			return prior
+		}
+	}
+	return types.StringValue(timestamp.Format(time.RFC3339))
+}
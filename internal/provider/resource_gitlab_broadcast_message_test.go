@@ -0,0 +1,88 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabBroadcastMessage_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabBroadcastMessage_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_broadcast_message" "this" {
+						message = "Scheduled maintenance"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "broadcast_type", "banner"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "dismissable", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_broadcast_message.this", "starts_at"),
+					resource.TestCheckResourceAttrSet("gitlab_broadcast_message.this", "ends_at"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_broadcast_message.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+					resource "gitlab_broadcast_message" "this" {
+						message              = "Scheduled maintenance on Sunday"
+						starts_at            = "2030-01-01T10:00:00Z"
+						ends_at              = "2030-01-01T12:00:00Z"
+						broadcast_type       = "notification"
+						target_path          = "*/welcome"
+						target_access_levels = ["developer", "maintainer"]
+						dismissable          = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "broadcast_type", "notification"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "target_access_levels.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_broadcast_message.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabBroadcastMessage_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_broadcast_message" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testutil.TestGitlabClient.BroadcastMessage.GetBroadcastMessage(id)
+		if err == nil {
+			return fmt.Errorf("broadcast message %d still exists", id)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
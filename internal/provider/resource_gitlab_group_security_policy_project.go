@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabGroupSecurityPolicyProjectResource{}
+	_ resource.ResourceWithConfigure   = &gitlabGroupSecurityPolicyProjectResource{}
+	_ resource.ResourceWithImportState = &gitlabGroupSecurityPolicyProjectResource{}
+)
+
+func init() {
+	registerResource(NewGitLabGroupSecurityPolicyProjectResource)
+}
+
+func NewGitLabGroupSecurityPolicyProjectResource() resource.Resource {
+	return &gitlabGroupSecurityPolicyProjectResource{}
+}
+
+type gitlabGroupSecurityPolicyProjectResource struct {
+	client *gitlab.Client
+}
+
+type gitlabGroupSecurityPolicyProjectResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Group         types.String `tfsdk:"group"`
+	PolicyProject types.String `tfsdk:"policy_project"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabGroupSecurityPolicyProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_security_policy_project"
+}
+
+func (r *gitlabGroupSecurityPolicyProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_group_security_policy_project`" + ` resource allows to link a security policy project to a group.
+
+The security policies of the linked project, e.g. managed with the ` + "`gitlab_security_policy`" + ` resource, are enforced in all projects of the group, including its subgroups.
+
+-> This resource requires a GitLab Enterprise instance with an Ultimate license.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationsecuritypolicyprojectassign)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<group>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the group to link the security policy project to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"policy_project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the security policy project. If the link is changed outside of Terraform, the full path of the linked project is shown in the plan.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabGroupSecurityPolicyProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create links the security policy project and adds it into the Terraform state.
+func (r *gitlabGroupSecurityPolicyProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabGroupSecurityPolicyProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.assign(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to link security policy project: %s", err.Error()))
+		return
+	}
+
+	data.Id = data.Group
+
+	tflog.Debug(ctx, "linked security policy project to group", map[string]interface{}{
+		"group": data.Group.ValueString(), "policy_project": data.PolicyProject.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabGroupSecurityPolicyProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabGroupSecurityPolicyProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.Id.ValueString()
+	group, _, err := r.client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "group does not exist, removing security policy project link from state", map[string]interface{}{
+				"group": groupID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read group %q: %s", groupID, err.Error()))
+		return
+	}
+
+	policyProject, err := readSecurityPolicyProject(ctx, r.client, "group", group.FullPath)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read security policy project of group %q: %s", groupID, err.Error()))
+		return
+	}
+	if policyProject == nil {
+		tflog.Debug(ctx, "group has no security policy project linked, removing from state", map[string]interface{}{
+			"group": groupID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Group = types.StringValue(groupID)
+	// keep the configured format of the policy project, unless it has been changed outside of Terraform.
+	if !securityPolicyProjectMatches(data.PolicyProject.ValueString(), policyProject) {
+		data.PolicyProject = types.StringValue(policyProject.FullPath)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update links a different security policy project to the group.
+func (r *gitlabGroupSecurityPolicyProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabGroupSecurityPolicyProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.assign(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to link security policy project: %s", err.Error()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the link to the security policy project.
+func (r *gitlabGroupSecurityPolicyProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabGroupSecurityPolicyProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.Id.ValueString()
+	group, _, err := r.client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read group %q: %s", groupID, err.Error()))
+		return
+	}
+
+	if err := unassignSecurityPolicyProject(ctx, r.client, group.FullPath); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to unlink security policy project: %s", err.Error()))
+	}
+}
+
+func (r *gitlabGroupSecurityPolicyProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *gitlabGroupSecurityPolicyProjectResource) assign(ctx context.Context, data *gitlabGroupSecurityPolicyProjectResourceModel) error {
+	group, _, err := r.client.Groups.GetGroup(data.Group.ValueString(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to read group %q: %w", data.Group.ValueString(), err)
+	}
+
+	policyProject, _, err := r.client.Projects.GetProject(data.PolicyProject.ValueString(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to read security policy project %q: %w", data.PolicyProject.ValueString(), err)
+	}
+
+	return assignSecurityPolicyProject(ctx, r.client, group.FullPath, policyProject.ID)
+}
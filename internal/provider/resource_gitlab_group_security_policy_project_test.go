@@ -0,0 +1,86 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupSecurityPolicyProject_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	firstPolicyProject := testutil.CreateProject(t)
+	secondPolicyProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabGroupSecurityPolicyProject_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Link a security policy project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_security_policy_project" "foo" {
+						group          = "%d"
+						policy_project = "%s"
+					}
+				`, testGroup.ID, firstPolicyProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("gitlab_group_security_policy_project.foo", "policy_project", firstPolicyProject.PathWithNamespace),
+			},
+			{
+				ResourceName:      "gitlab_group_security_policy_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Link a different security policy project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_security_policy_project" "foo" {
+						group          = "%d"
+						policy_project = "%s"
+					}
+				`, testGroup.ID, secondPolicyProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("gitlab_group_security_policy_project.foo", "policy_project", secondPolicyProject.PathWithNamespace),
+			},
+			{
+				ResourceName:      "gitlab_group_security_policy_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabGroupSecurityPolicyProject_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_security_policy_project" {
+			continue
+		}
+
+		group, _, err := testutil.TestGitlabClient.Groups.GetGroup(rs.Primary.ID, nil)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+
+		policyProject, err := readSecurityPolicyProject(context.Background(), testutil.TestGitlabClient, "group", group.FullPath)
+		if err != nil {
+			return err
+		}
+		if policyProject != nil {
+			return fmt.Errorf("Security policy project %s is still linked to group %s", policyProject.FullPath, group.FullPath)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &gitlabInstanceFeatureResource{}
+	_ resource.ResourceWithConfigure      = &gitlabInstanceFeatureResource{}
+	_ resource.ResourceWithImportState    = &gitlabInstanceFeatureResource{}
+	_ resource.ResourceWithValidateConfig = &gitlabInstanceFeatureResource{}
+)
+
+func init() {
+	registerResource(NewGitLabInstanceFeatureResource)
+}
+
+func NewGitLabInstanceFeatureResource() resource.Resource {
+	return &gitlabInstanceFeatureResource{}
+}
+
+type gitlabInstanceFeatureResource struct {
+	client *gitlab.Client
+}
+
+type gitlabInstanceFeatureResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	PercentageOfTime   types.Int64    `tfsdk:"percentage_of_time"`
+	PercentageOfActors types.Int64    `tfsdk:"percentage_of_actors"`
+	FeatureGroups      []types.String `tfsdk:"feature_groups"`
+	Users              []types.String `tfsdk:"users"`
+	Groups             []types.String `tfsdk:"groups"`
+	Namespaces         []types.String `tfsdk:"namespaces"`
+	Projects           []types.String `tfsdk:"projects"`
+	State              types.String   `tfsdk:"state"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabInstanceFeatureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_feature"
+}
+
+func (r *gitlabInstanceFeatureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_instance_feature`" + ` resource allows to manage the gates of a feature flag of the GitLab instance itself.
+
+A feature is either enabled for everyone with ` + "`enabled = true`" + `, or conditionally enabled with any combination of percentage, feature group and actor gates.
+
+~> GitLab feature flags are meant for development and may change or be removed without notice. Refer to the GitLab documentation of the specific feature before enabling it.
+
+-> Every change re-applies all gates of the feature: it is disabled first and the configured gates are set afterwards.
+   Destroying this resource removes the feature flag, which resets it to its default state.
+
+-> Requires administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/features.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<name>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feature.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feature is enabled for everyone. Can't be combined with any other gate. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"percentage_of_time": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of time the feature is enabled.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, 100)},
+			},
+			"percentage_of_actors": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of actors the feature is enabled for.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, 100)},
+			},
+			"feature_groups": schema.SetAttribute{
+				MarkdownDescription: "The feature groups to enable the feature for, e.g. `gitlab_team_members`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The usernames of the users to enable the feature for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "The full paths of the groups to enable the feature for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"namespaces": schema.SetAttribute{
+				MarkdownDescription: "The full paths of the namespaces to enable the feature for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The full paths of the projects to enable the feature for.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the feature. One of `on`, `off` or `conditional`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that the boolean gate is not combined with any other gate,
+// because enabling the feature for everyone clears all other gates.
+func (r *gitlabInstanceFeatureResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *gitlabInstanceFeatureResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.Enabled.ValueBool() {
+		return
+	}
+
+	if len(data.gates()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Conflicting feature gates",
+			"A feature enabled for everyone can't have any other gate. Either remove `enabled` or all other gates.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabInstanceFeatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create sets the gates of the feature and adds it into the Terraform state.
+func (r *gitlabInstanceFeatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabInstanceFeatureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to set gates of feature %q: %s", data.Name.ValueString(), err.Error()))
+		return
+	}
+	data.Id = data.Name
+
+	if _, err := r.read(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature %q: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabInstanceFeatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabInstanceFeatureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.read(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature %q: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, "instance feature does not exist, removing from state", map[string]interface{}{
+			"name": data.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update re-applies all gates of the feature.
+func (r *gitlabInstanceFeatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabInstanceFeatureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to set gates of feature %q: %s", data.Name.ValueString(), err.Error()))
+		return
+	}
+
+	if _, err := r.read(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature %q: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the feature, which resets it to its default state.
+func (r *gitlabInstanceFeatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabInstanceFeatureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpReq, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("features/%s", gitlab.PathEscape(data.Id.ValueString())), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err == nil {
+		_, err = r.client.Do(httpReq, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete feature %q: %s", data.Id.ValueString(), err.Error()))
+	}
+}
+
+func (r *gitlabInstanceFeatureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sets the gates of the feature. Enabling or disabling the feature for everyone clears all other gates,
+// therefore the feature is disabled first and each conditional gate is set afterwards.
+func (r *gitlabInstanceFeatureResource) apply(ctx context.Context, data *gitlabInstanceFeatureResourceModel) error {
+	name := data.Name.ValueString()
+	if err := r.setGate(ctx, name, &instanceFeatureGateOptions{Value: strconv.FormatBool(data.Enabled.ValueBool())}); err != nil {
+		return err
+	}
+
+	for _, gate := range data.gates() {
+		if err := r.setGate(ctx, name, gate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *gitlabInstanceFeatureResource) setGate(ctx context.Context, name string, options *instanceFeatureGateOptions) error {
+	tflog.Debug(ctx, "setting instance feature gate", map[string]interface{}{
+		"name": name, "value": options.Value, "key": options.Key, "feature_group": options.FeatureGroup,
+		"user": options.User, "group": options.Group, "namespace": options.Namespace, "project": options.Project,
+	})
+
+	req, err := r.client.NewRequest(http.MethodPost, fmt.Sprintf("features/%s", gitlab.PathEscape(name)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Do(req, nil)
+	return err
+}
+
+// read refreshes the model with the gates of the feature. It returns false if the feature doesn't exist.
+func (r *gitlabInstanceFeatureResource) read(ctx context.Context, data *gitlabInstanceFeatureResourceModel) (bool, error) {
+	features, _, err := r.client.Features.ListFeatures(gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	var feature *gitlab.Feature
+	for _, f := range features {
+		if f.Name == data.Id.ValueString() {
+			feature = f
+		}
+	}
+	if feature == nil {
+		return false, nil
+	}
+
+	data.Name = types.StringValue(feature.Name)
+	data.State = types.StringValue(feature.State)
+	data.Enabled = types.BoolValue(false)
+	data.PercentageOfTime = types.Int64Null()
+	data.PercentageOfActors = types.Int64Null()
+	data.FeatureGroups, data.Users, data.Groups, data.Namespaces, data.Projects = nil, nil, nil, nil, nil
+
+	for _, gate := range feature.Gates {
+		switch gate.Key {
+		case "boolean":
+			data.Enabled = types.BoolValue(fmt.Sprint(gate.Value) == "true")
+		case "percentage_of_time", "percentage_of_actors":
+			percentage, err := strconv.ParseFloat(fmt.Sprint(gate.Value), 64)
+			if err != nil || percentage == 0 {
+				continue
+			}
+			if gate.Key == "percentage_of_time" {
+				data.PercentageOfTime = types.Int64Value(int64(percentage))
+			} else {
+				data.PercentageOfActors = types.Int64Value(int64(percentage))
+			}
+		case "groups":
+			for _, group := range gateValues(gate.Value) {
+				data.FeatureGroups = append(data.FeatureGroups, types.StringValue(group))
+			}
+		case "actors":
+			for _, actor := range gateValues(gate.Value) {
+				if err := data.appendActor(ctx, r.client, actor); err != nil {
+					return false, fmt.Errorf("unable to read actor %q: %w", actor, err)
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+// appendActor resolves the flipper ID of an actor, e.g. `User:42`, to the username or full path used in the configuration.
+func (data *gitlabInstanceFeatureResourceModel) appendActor(ctx context.Context, client *gitlab.Client, actor string) error {
+	kind, rawID, found := strings.Cut(actor, ":")
+	if !found {
+		return nil
+	}
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		return nil
+	}
+
+	switch kind {
+	case "User":
+		user, _, err := client.Users.GetUser(id, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		data.Users = append(data.Users, types.StringValue(user.Username))
+	case "Group":
+		group, _, err := client.Groups.GetGroup(id, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		data.Groups = append(data.Groups, types.StringValue(group.FullPath))
+	case "Namespace":
+		namespace, _, err := client.Namespaces.GetNamespace(id, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		data.Namespaces = append(data.Namespaces, types.StringValue(namespace.FullPath))
+	case "Project":
+		project, _, err := client.Projects.GetProject(id, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		data.Projects = append(data.Projects, types.StringValue(project.PathWithNamespace))
+	}
+	return nil
+}
+
+// gates returns the options to set each conditional gate of the feature.
+func (data *gitlabInstanceFeatureResourceModel) gates() []*instanceFeatureGateOptions {
+	var gates []*instanceFeatureGateOptions
+	if !data.PercentageOfTime.IsNull() {
+		gates = append(gates, &instanceFeatureGateOptions{Value: strconv.FormatInt(data.PercentageOfTime.ValueInt64(), 10), Key: "percentage_of_time"})
+	}
+	if !data.PercentageOfActors.IsNull() {
+		gates = append(gates, &instanceFeatureGateOptions{Value: strconv.FormatInt(data.PercentageOfActors.ValueInt64(), 10), Key: "percentage_of_actors"})
+	}
+	for _, group := range data.FeatureGroups {
+		gates = append(gates, &instanceFeatureGateOptions{Value: "true", FeatureGroup: group.ValueString()})
+	}
+	for _, user := range data.Users {
+		gates = append(gates, &instanceFeatureGateOptions{Value: "true", User: user.ValueString()})
+	}
+	for _, group := range data.Groups {
+		gates = append(gates, &instanceFeatureGateOptions{Value: "true", Group: group.ValueString()})
+	}
+	for _, namespace := range data.Namespaces {
+		gates = append(gates, &instanceFeatureGateOptions{Value: "true", Namespace: namespace.ValueString()})
+	}
+	for _, project := range data.Projects {
+		gates = append(gates, &instanceFeatureGateOptions{Value: "true", Project: project.ValueString()})
+	}
+	return gates
+}
+
+// gateValues returns the values of a gate, which are returned by the API as a list.
+func gateValues(value interface{}) []string {
+	var values []string
+	if list, ok := value.([]interface{}); ok {
+		for _, v := range list {
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	return values
+}
+
+// instanceFeatureGateOptions are the options to set a single gate of a feature,
+// go-gitlab only supports setting the value of a feature.
+type instanceFeatureGateOptions struct {
+	Value        string `json:"value"`
+	Key          string `json:"key,omitempty"`
+	FeatureGroup string `json:"feature_group,omitempty"`
+	User         string `json:"user,omitempty"`
+	Group        string `json:"group,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Project      string `json:"project,omitempty"`
+}
@@ -0,0 +1,103 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabInstanceFeature_basic(t *testing.T) {
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabInstanceFeature_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_instance_feature" "this" {
+						name    = "terraform_acceptance_feature"
+						enabled = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "state", "on"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_instance_feature.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_instance_feature" "this" {
+						name               = "terraform_acceptance_feature"
+						percentage_of_time = 25
+						users              = ["%s"]
+						groups             = ["%s"]
+					}
+				`, testUser.Username, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "state", "conditional"),
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "percentage_of_time", "25"),
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "users.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_instance_feature.this", "groups.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_instance_feature.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabInstanceFeature_conflictingGates(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_instance_feature" "this" {
+						name               = "terraform_acceptance_feature_conflict"
+						enabled            = true
+						percentage_of_time = 25
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Conflicting feature gates`),
+			},
+		},
+	})
+}
+
+func testAcc_GitlabInstanceFeature_CheckDestroy(s *terraform.State) error {
+	features, _, err := testutil.TestGitlabClient.Features.ListFeatures()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_instance_feature" {
+			continue
+		}
+
+		for _, feature := range features {
+			if feature.Name == rs.Primary.ID {
+				return fmt.Errorf("feature %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
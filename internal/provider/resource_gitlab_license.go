@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabLicenseResource{}
+	_ resource.ResourceWithConfigure   = &gitlabLicenseResource{}
+	_ resource.ResourceWithImportState = &gitlabLicenseResource{}
+	_ resource.ResourceWithModifyPlan  = &gitlabLicenseResource{}
+)
+
+func init() {
+	registerResource(NewGitLabLicenseResource)
+}
+
+func NewGitLabLicenseResource() resource.Resource {
+	return &gitlabLicenseResource{}
+}
+
+type gitlabLicenseResource struct {
+	client *gitlab.Client
+}
+
+type gitlabLicenseResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	License               types.String `tfsdk:"license"`
+	ExpirationWarningDays types.Int64  `tfsdk:"expiration_warning_days"`
+	Plan                  types.String `tfsdk:"plan"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	StartsAt              types.String `tfsdk:"starts_at"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+	Expired               types.Bool   `tfsdk:"expired"`
+	UserLimit             types.Int64  `tfsdk:"user_limit"`
+	ActiveUsers           types.Int64  `tfsdk:"active_users"`
+	MaximumUserCount      types.Int64  `tfsdk:"maximum_user_count"`
+	HistoricalMax         types.Int64  `tfsdk:"historical_max"`
+	Overage               types.Int64  `tfsdk:"overage"`
+	LicenseeName          types.String `tfsdk:"licensee_name"`
+	LicenseeEmail         types.String `tfsdk:"licensee_email"`
+	LicenseeCompany       types.String `tfsdk:"licensee_company"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabLicenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (r *gitlabLicenseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_license`" + ` resource allows to manage the lifecycle of a license of a self-managed GitLab instance.
+
+A warning is shown during planning when the license expires within ` + "`expiration_warning_days`" + ` days or has already expired.
+
+-> Requires administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/license.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the license.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"license": schema.StringAttribute{
+				MarkdownDescription: "The license key, as delivered in the `.gitlab-license` file. Changing it adds a new license, except for imported licenses whose key is unknown.",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIf(
+					func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = req.StateValue.ValueString() != ""
+					},
+					"Changing the license key adds a new license.",
+					"Changing the license key adds a new license.",
+				)},
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"expiration_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days before the expiry of the license a warning is shown during planning. Defaults to `30`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The plan of the license.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the license was added.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "The date the license starts, in the format `YYYY-MM-DD`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The date the license expires, in the format `YYYY-MM-DD`. Empty if the license never expires.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license has expired.",
+				Computed:            true,
+			},
+			"user_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats of the license.",
+				Computed:            true,
+			},
+			"active_users": schema.Int64Attribute{
+				MarkdownDescription: "The number of active users.",
+				Computed:            true,
+			},
+			"maximum_user_count": schema.Int64Attribute{
+				MarkdownDescription: "The highest number of billable users in the current license term.",
+				Computed:            true,
+			},
+			"historical_max": schema.Int64Attribute{
+				MarkdownDescription: "The highest number of billable users in the last year.",
+				Computed:            true,
+			},
+			"overage": schema.Int64Attribute{
+				MarkdownDescription: "The number of users exceeding the seats of the license.",
+				Computed:            true,
+			},
+			"licensee_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the licensee.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"licensee_email": schema.StringAttribute{
+				MarkdownDescription: "The email of the licensee.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"licensee_company": schema.StringAttribute{
+				MarkdownDescription: "The company of the licensee.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when the license is about to expire or has expired.
+// The expiry is only known once the license has been added, therefore nothing is checked for new licenses.
+func (r *gitlabLicenseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *gitlabLicenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.License.IsUnknown() || plan.ExpirationWarningDays.IsUnknown() || !plan.License.Equal(state.License) {
+		return
+	}
+
+	resp.Diagnostics.Append(licenseExpirationWarning(state, plan.ExpirationWarningDays.ValueInt64(), time.Now())...)
+}
+
+// licenseExpirationWarning returns a warning if the license expires within the given number of days.
+func licenseExpirationWarning(data *gitlabLicenseResourceModel, warningDays int64, now time.Time) (diags diag.Diagnostics) {
+	if data.ExpiresAt.ValueString() == "" {
+		return
+	}
+	expiresAt, err := time.Parse("2006-01-02", data.ExpiresAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	days := int64(expiresAt.Sub(now).Hours() / 24)
+	switch {
+	case !expiresAt.After(now):
+		diags.AddAttributeWarning(
+			path.Root("license"),
+			"GitLab license has expired",
+			fmt.Sprintf("The license %s expired on %s. Add a new license to keep using the features of the %q plan.", data.Id.ValueString(), data.ExpiresAt.ValueString(), data.Plan.ValueString()),
+		)
+	case days <= warningDays:
+		diags.AddAttributeWarning(
+			path.Root("license"),
+			"GitLab license is about to expire",
+			fmt.Sprintf("The license %s expires on %s, in %d days. Add a new license to keep using the features of the %q plan.", data.Id.ValueString(), data.ExpiresAt.ValueString(), days, data.Plan.ValueString()),
+		)
+	}
+	return
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabLicenseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create adds the license and adds it into the Terraform state.
+func (r *gitlabLicenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabLicenseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, _, err := r.client.License.AddLicense(&gitlab.AddLicenseOptions{License: gitlab.String(data.License.ValueString())}, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to add license: %s", err.Error()))
+		return
+	}
+	tflog.Debug(ctx, "added license", map[string]interface{}{
+		"id": license.ID, "plan": license.Plan,
+	})
+
+	r.licenseToStateModel(license, data)
+	resp.Diagnostics.Append(licenseExpirationWarning(data, data.ExpirationWarningDays.ValueInt64(), time.Now())...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabLicenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabLicenseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.getLicense(ctx, data.Id.ValueString())
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "license does not exist, removing from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read license %s: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+
+	// The license key can't be read from the API, e.g. when importing.
+	if data.License.IsNull() {
+		data.License = types.StringValue("")
+	}
+	if data.ExpirationWarningDays.IsNull() {
+		data.ExpirationWarningDays = types.Int64Value(30)
+	}
+	r.licenseToStateModel(license, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only changes attributes which are not stored in GitLab,
+// like the warning threshold or the license key of an imported license.
+func (r *gitlabLicenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabLicenseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.getLicense(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read license %s: %s", data.Id.ValueString(), err.Error()))
+		return
+	}
+	r.licenseToStateModel(license, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the license.
+func (r *gitlabLicenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabLicenseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Internal provider error", fmt.Sprintf("Unable to convert license id to int: %s", err.Error()))
+		return
+	}
+
+	if _, err := r.client.License.DeleteLicense(id, gitlab.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete license %d: %s", id, err.Error()))
+	}
+}
+
+func (r *gitlabLicenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getLicense gets a single license, which is not yet supported by go-gitlab.
+func (r *gitlabLicenseResource) getLicense(ctx context.Context, id string) (*gitlab.License, error) {
+	req, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("license/%s", id), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	license := new(gitlab.License)
+	if _, err := r.client.Do(req, license); err != nil {
+		return nil, err
+	}
+	return license, nil
+}
+
+func (r *gitlabLicenseResource) licenseToStateModel(license *gitlab.License, data *gitlabLicenseResourceModel) {
+	data.Id = types.StringValue(strconv.Itoa(license.ID))
+	data.Plan = types.StringValue(license.Plan)
+	data.CreatedAt = types.StringValue("")
+	if license.CreatedAt != nil {
+		data.CreatedAt = types.StringValue(license.CreatedAt.Format(time.RFC3339))
+	}
+	data.StartsAt = types.StringValue("")
+	if license.StartsAt != nil {
+		data.StartsAt = types.StringValue(license.StartsAt.String())
+	}
+	data.ExpiresAt = types.StringValue("")
+	if license.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(license.ExpiresAt.String())
+	}
+	data.Expired = types.BoolValue(license.Expired)
+	data.UserLimit = types.Int64Value(int64(license.UserLimit))
+	data.ActiveUsers = types.Int64Value(int64(license.ActiveUsers))
+	data.MaximumUserCount = types.Int64Value(int64(license.MaximumUserCount))
+	data.HistoricalMax = types.Int64Value(int64(license.HistoricalMax))
+	data.Overage = types.Int64Value(int64(license.Overage))
+	data.LicenseeName = types.StringValue(license.Licensee.Name)
+	data.LicenseeEmail = types.StringValue(license.Licensee.Email)
+	data.LicenseeCompany = types.StringValue(license.Licensee.Company)
+}
@@ -0,0 +1,79 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabLicense_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	// A valid license can't be generated for the tests, it has to be provided.
+	license := os.Getenv("GITLAB_TEST_LICENSE")
+	if license == "" {
+		t.Skip("GITLAB_TEST_LICENSE must be set to run the license acceptance tests")
+	}
+
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_license" "this" {
+						license = %q
+					}
+				`, license),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "plan"),
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "user_limit"),
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "expires_at"),
+					resource.TestCheckResourceAttr("gitlab_license.this", "expiration_warning_days", "30"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_license.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"license"},
+			},
+			// Warn for every license with a large enough threshold
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_license" "this" {
+						license                 = %q
+						expiration_warning_days = 36500
+					}
+				`, license),
+				Check: resource.TestCheckResourceAttr("gitlab_license.this", "expiration_warning_days", "36500"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabLicense_invalid(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_license" "this" {
+						license = "not-a-license"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Unable to add license`),
+			},
+		},
+	})
+}
@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabMemberRoleResource{}
+	_ resource.ResourceWithConfigure   = &gitlabMemberRoleResource{}
+	_ resource.ResourceWithImportState = &gitlabMemberRoleResource{}
+)
+
+func init() {
+	registerResource(NewGitLabMemberRoleResource)
+}
+
+func NewGitLabMemberRoleResource() resource.Resource {
+	return &gitlabMemberRoleResource{}
+}
+
+type gitlabMemberRoleResource struct {
+	client *gitlab.Client
+}
+
+type gitlabMemberRoleResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	GroupId         types.String   `tfsdk:"group_id"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	BaseAccessLevel types.String   `tfsdk:"base_access_level"`
+	Permissions     []types.String `tfsdk:"permissions"`
+	MemberRoleId    types.Int64    `tfsdk:"member_role_id"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabMemberRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_role"
+}
+
+func (r *gitlabMemberRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_member_role`" + ` resource allows to manage the lifecycle of a custom member role.
+
+A custom member role is based on one of the default access levels and grants additional permissions on top of it.
+It can be assigned to members with the ` + "`member_role_id`" + ` attribute of the membership and share group resources.
+
+-> On GitLab.com custom member roles are managed on top-level groups, on self-managed GitLab they are managed on the instance.
+
+-> Custom member roles can't be updated with the REST API, therefore any change recreates the role.
+
+-> This resource requires a GitLab Enterprise instance with an Ultimate license.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/member_roles.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<group_id>:<member_role_id>` for group member roles or `<member_role_id>` for instance member roles.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the top-level group to create the member role in. If omitted, an instance member role is created.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the member role.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the member role.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"base_access_level": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The default access level the member role is based on. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidMemberRoleBaseAccessLevelNames)),
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidMemberRoleBaseAccessLevelNames...)},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("The permissions granted on top of the base access level. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidMemberRolePermissions)),
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(api.ValidMemberRolePermissions...)),
+				},
+			},
+			"member_role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the member role, to be used as `member_role_id` of memberships.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new member role and adds it into the Terraform state.
+func (r *gitlabMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabMemberRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := data.GroupId.ValueString()
+
+	// NOTE: the permissions are sent as boolean attributes.
+	options := map[string]interface{}{
+		"name":              data.Name.ValueString(),
+		"base_access_level": api.AccessLevelNameToValue[data.BaseAccessLevel.ValueString()],
+	}
+	if !data.Description.IsNull() {
+		options["description"] = data.Description.ValueString()
+	}
+	for _, permission := range data.Permissions {
+		options[permission.ValueString()] = true
+	}
+
+	httpReq, err := r.client.NewRequest(http.MethodPost, api.MemberRolesPath(group), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create member role request: %s", err.Error()))
+		return
+	}
+	var memberRole api.MemberRole
+	if _, err := r.client.Do(httpReq, &memberRole); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create member role: %s", err.Error()))
+		return
+	}
+
+	memberRoleID := strconv.Itoa(memberRole.ID)
+	if group != "" {
+		data.Id = types.StringValue(utils.BuildTwoPartID(&group, &memberRoleID))
+	} else {
+		data.Id = types.StringValue(memberRoleID)
+	}
+	data.MemberRoleId = types.Int64Value(int64(memberRole.ID))
+
+	tflog.Debug(ctx, "created member role", map[string]interface{}{
+		"group": group, "member_role_id": memberRole.ID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabMemberRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, memberRoleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<group_id>:<member_role_id>' or '<member_role_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// NOTE: there is no API to get a single member role.
+	memberRoles, err := api.ListMemberRoles(ctx, r.client, group)
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "group does not exist, removing member role from state", map[string]interface{}{
+				"group": group, "member_role_id": memberRoleID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read member roles: %s", err.Error()))
+		return
+	}
+
+	var memberRole *api.MemberRole
+	for _, m := range memberRoles {
+		if m.ID == memberRoleID {
+			memberRole = m
+			break
+		}
+	}
+	if memberRole == nil {
+		tflog.Debug(ctx, "member role does not exist, removing from state", map[string]interface{}{
+			"group": group, "member_role_id": memberRoleID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if group != "" {
+		data.GroupId = types.StringValue(group)
+	}
+	data.Name = types.StringValue(memberRole.Name)
+	if memberRole.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(memberRole.Description)
+	}
+	data.BaseAccessLevel = types.StringValue(api.AccessLevelValueToName[memberRole.BaseAccessLevel])
+	var permissions []string
+	for permission := range memberRole.Permissions {
+		permissions = append(permissions, permission)
+	}
+	sort.Strings(permissions)
+	data.Permissions = nil
+	for _, permission := range permissions {
+		data.Permissions = append(data.Permissions, types.StringValue(permission))
+	}
+	data.MemberRoleId = types.Int64Value(int64(memberRole.ID))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is a no-op, because all attributes require a replacement of the resource.
+func (r *gitlabMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Provider Error, report upstream",
+		"Somehow the resource was requested to perform an in-place upgrade which is not possible.",
+	)
+}
+
+// Delete removes the member role.
+func (r *gitlabMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabMemberRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, memberRoleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<group_id>:<member_role_id>' or '<member_role_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	httpReq, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%d", api.MemberRolesPath(group), memberRoleID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create member role request: %s", err.Error()))
+		return
+	}
+	if _, err := r.client.Do(httpReq, nil); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete member role %d: %s", memberRoleID, err.Error()))
+		return
+	}
+}
+
+func (r *gitlabMemberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// parseID returns the group, which is empty for instance member roles, and the member role ID.
+func (r *gitlabMemberRoleResource) parseID(id string) (string, int, error) {
+	group := ""
+	memberRoleIDFromID := id
+	if g, m, err := utils.ParseTwoPartID(id); err == nil {
+		group, memberRoleIDFromID = g, m
+	}
+
+	memberRoleID, err := strconv.Atoi(memberRoleIDFromID)
+	if err != nil {
+		return "", 0, fmt.Errorf("member role id %q is not a number: %w", memberRoleIDFromID, err)
+	}
+	return group, memberRoleID, nil
+}
@@ -0,0 +1,104 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabMemberRole_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "16.0")
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6MuxProviderFactories,
+		CheckDestroy:             testAcc_GitlabMemberRole_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create a member role and assign it to a group member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_member_role" "this" {
+						name              = "Security Reviewer"
+						description       = "Reviews vulnerabilities"
+						base_access_level = "reporter"
+						permissions       = ["read_vulnerability", "admin_merge_request"]
+					}
+
+					resource "gitlab_group_membership" "this" {
+						group_id       = "%d"
+						user_id        = %d
+						access_level   = "reporter"
+						member_role_id = gitlab_member_role.this.member_role_id
+					}
+				`, testGroup.ID, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_member_role.this", "member_role_id"),
+					resource.TestCheckResourceAttrPair("gitlab_group_membership.this", "member_role_id", "gitlab_member_role.this", "member_role_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_member_role.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change the permissions, which recreates the member role
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_member_role" "this" {
+						name              = "Security Reviewer"
+						description       = "Reviews vulnerabilities"
+						base_access_level = "reporter"
+						permissions       = ["read_vulnerability"]
+					}
+
+					resource "gitlab_group_membership" "this" {
+						group_id       = "%d"
+						user_id        = %d
+						access_level   = "reporter"
+						member_role_id = gitlab_member_role.this.member_role_id
+					}
+				`, testGroup.ID, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_member_role.this", "permissions.#", "1"),
+					resource.TestCheckResourceAttrPair("gitlab_group_membership.this", "member_role_id", "gitlab_member_role.this", "member_role_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_member_role.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabMemberRole_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_member_role" {
+			continue
+		}
+
+		memberRoles, err := api.ListMemberRoles(context.Background(), testutil.TestGitlabClient, rs.Primary.Attributes["group_id"])
+		if err != nil {
+			return err
+		}
+		for _, m := range memberRoles {
+			if fmt.Sprintf("%d", m.ID) == rs.Primary.Attributes["member_role_id"] {
+				return fmt.Errorf("member role %d still exists", m.ID)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabPlanLimitsResource{}
+	_ resource.ResourceWithConfigure   = &gitlabPlanLimitsResource{}
+	_ resource.ResourceWithImportState = &gitlabPlanLimitsResource{}
+)
+
+func init() {
+	registerResource(NewGitLabPlanLimitsResource)
+}
+
+func NewGitLabPlanLimitsResource() resource.Resource {
+	return &gitlabPlanLimitsResource{}
+}
+
+var validPlanLimitsPlanNames = []string{"default", "free", "bronze", "silver", "premium", "gold", "ultimate", "ultimate_trial", "premium_trial", "opensource"}
+
+// planLimitsAttributes are the limits of a plan, mapped to their description.
+// go-gitlab only supports the package file size limits, therefore the limits are handled by their API name.
+var planLimitsAttributes = []struct {
+	Name        string
+	Description string
+}{
+	{"ci_pipeline_size", "The maximum number of jobs in a single pipeline."},
+	{"ci_active_jobs", "The total number of jobs in currently active pipelines."},
+	{"ci_project_subscriptions", "The maximum number of pipeline subscriptions to and from a project."},
+	{"ci_pipeline_schedules", "The maximum number of pipeline schedules."},
+	{"ci_needs_size_limit", "The maximum number of needs dependencies a job can have."},
+	{"ci_registered_group_runners", "The maximum number of runners registered per group."},
+	{"ci_registered_project_runners", "The maximum number of runners registered per project."},
+	{"dotenv_size", "The maximum size of a dotenv artifact in bytes."},
+	{"dotenv_variables", "The maximum number of variables in a dotenv artifact."},
+	{"pipeline_hierarchy_size", "The maximum number of downstream pipelines in a pipeline's hierarchy tree."},
+	{"storage_size_limit", "The maximum storage size for the root namespace in MiB."},
+	{"enforcement_limit", "The maximum storage size for the root namespace limit enforcement in MiB."},
+	{"notification_limit", "The maximum storage size for the root namespace limit storage notifications in MiB."},
+	{"conan_max_file_size", "The maximum Conan package file size in bytes."},
+	{"generic_packages_max_file_size", "The maximum generic package file size in bytes."},
+	{"helm_max_file_size", "The maximum Helm chart file size in bytes."},
+	{"maven_max_file_size", "The maximum Maven package file size in bytes."},
+	{"npm_max_file_size", "The maximum npm package file size in bytes."},
+	{"nuget_max_file_size", "The maximum NuGet package file size in bytes."},
+	{"pypi_max_file_size", "The maximum PyPI package file size in bytes."},
+	{"terraform_module_max_file_size", "The maximum Terraform Module package file size in bytes."},
+}
+
+type gitlabPlanLimitsResource struct {
+	client *gitlab.Client
+}
+
+// Metadata returns the resource name
+func (r *gitlabPlanLimitsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plan_limits"
+}
+
+func (r *gitlabPlanLimitsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of this Terraform resource. In the format of `<plan_name>`.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"plan_name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the plan to manage the limits of. Valid values are: %s.", utils.RenderValueListForDocs(validPlanLimitsPlanNames)),
+			Required:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.OneOf(validPlanLimitsPlanNames...)},
+		},
+	}
+	for _, limit := range planLimitsAttributes {
+		attributes[limit.Name] = schema.Int64Attribute{
+			MarkdownDescription: limit.Description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_plan_limits`" + ` resource allows to manage the limits of a plan of a GitLab instance.
+
+Only the configured limits are changed, all other limits are read from the instance.
+
+!> This resource does not implement any destroy logic, it's a no-op at this point.
+   The limits of the plan remain as they are when the resource is destroyed.
+
+-> Requires administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/plan_limits.html)`,
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabPlanLimitsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create changes the configured limits of the plan and adds it into the Terraform state.
+func (r *gitlabPlanLimitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.set(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabPlanLimitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, id.ValueString(), &resp.State)...)
+}
+
+// Update changes the configured limits of the plan.
+func (r *gitlabPlanLimitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.set(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the plan limits from the Terraform state only.
+func (r *gitlabPlanLimitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "destroying the plan limits does not yet do anything")
+}
+
+func (r *gitlabPlanLimitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *gitlabPlanLimitsResource) set(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State, diags *diag.Diagnostics) {
+	var planName types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("plan_name"), &planName)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Only the configured limits are sent, the API leaves all other limits unchanged.
+	options := map[string]interface{}{"plan_name": planName.ValueString()}
+	for _, limit := range planLimitsAttributes {
+		var value types.Int64
+		diags.Append(plan.GetAttribute(ctx, path.Root(limit.Name), &value)...)
+		if diags.HasError() {
+			return
+		}
+		if !value.IsNull() && !value.IsUnknown() {
+			options[limit.Name] = value.ValueInt64()
+		}
+	}
+
+	tflog.Debug(ctx, "changing plan limits", options)
+	httpReq, err := r.client.NewRequest(http.MethodPut, "application/plan_limits", options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err == nil {
+		_, err = r.client.Do(httpReq, nil)
+	}
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to change limits of plan %q: %s", planName.ValueString(), err.Error()))
+		return
+	}
+
+	diags.Append(r.read(ctx, planName.ValueString(), state)...)
+}
+
+func (r *gitlabPlanLimitsResource) read(ctx context.Context, planName string, state *tfsdk.State) (diags diag.Diagnostics) {
+	options := &gitlab.GetCurrentPlanLimitsOptions{PlanName: gitlab.String(planName)}
+	httpReq, err := r.client.NewRequest(http.MethodGet, "application/plan_limits", options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read limits of plan %q: %s", planName, err.Error()))
+		return
+	}
+	var limits map[string]interface{}
+	if _, err := r.client.Do(httpReq, &limits); err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read limits of plan %q: %s", planName, err.Error()))
+		return
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), planName)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("plan_name"), planName)...)
+	for _, limit := range planLimitsAttributes {
+		value := types.Int64Null()
+		// JSON numbers are decoded as float64
+		if v, ok := limits[limit.Name].(float64); ok {
+			value = types.Int64Value(int64(v))
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root(limit.Name), value)...)
+	}
+	return
+}
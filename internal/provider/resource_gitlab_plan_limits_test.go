@@ -0,0 +1,57 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabPlanLimits_basic(t *testing.T) {
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_plan_limits" "this" {
+						plan_name        = "default"
+						ci_pipeline_size = 100
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_plan_limits.this", "id", "default"),
+					resource.TestCheckResourceAttr("gitlab_plan_limits.this", "ci_pipeline_size", "100"),
+					resource.TestCheckResourceAttrSet("gitlab_plan_limits.this", "ci_active_jobs"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_plan_limits.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+					resource "gitlab_plan_limits" "this" {
+						plan_name                      = "default"
+						ci_pipeline_size               = 0
+						ci_registered_project_runners  = 500
+						generic_packages_max_file_size = 1073741824
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_plan_limits.this", "ci_pipeline_size", "0"),
+					resource.TestCheckResourceAttr("gitlab_plan_limits.this", "ci_registered_project_runners", "500"),
+					resource.TestCheckResourceAttr("gitlab_plan_limits.this", "generic_packages_max_file_size", "1073741824"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_plan_limits.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
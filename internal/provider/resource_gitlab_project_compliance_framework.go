@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabProjectComplianceFrameworkResource{}
+	_ resource.ResourceWithConfigure   = &gitlabProjectComplianceFrameworkResource{}
+	_ resource.ResourceWithImportState = &gitlabProjectComplianceFrameworkResource{}
+)
+
+var complianceFrameworkGlobalIDRegex = regexp.MustCompile(`^gid://gitlab/ComplianceManagement::Framework/\d+$`)
+
+func init() {
+	registerResource(NewGitLabProjectComplianceFrameworkResource)
+}
+
+func NewGitLabProjectComplianceFrameworkResource() resource.Resource {
+	return &gitlabProjectComplianceFrameworkResource{}
+}
+
+type gitlabProjectComplianceFrameworkResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectComplianceFrameworkResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Project               types.String `tfsdk:"project"`
+	ComplianceFrameworkId types.String `tfsdk:"compliance_framework_id"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectComplianceFrameworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_compliance_framework"
+}
+
+func (r *gitlabProjectComplianceFrameworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_compliance_framework`" + ` resource allows to manage the lifecycle of a compliance framework assignment to a project.
+
+-> A project can only have a single compliance framework assigned. Assigning another framework to the same project with a second resource will overwrite the first assignment.
+
+-> This resource requires a GitLab Enterprise instance with a Premium license.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationprojectsetcomplianceframework)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<compliance_framework_id>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project to assign the compliance framework to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"compliance_framework_id": schema.StringAttribute{
+				MarkdownDescription: "Globally unique ID of the compliance framework to assign to the project, e.g. the `framework_id` attribute of the `gitlab_compliance_framework` resource.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(complianceFrameworkGlobalIDRegex, "value must be a compliance framework global ID, e.g. `gid://gitlab/ComplianceManagement::Framework/42`"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectComplianceFrameworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create assigns the compliance framework to the project and adds it into the Terraform state.
+func (r *gitlabProjectComplianceFrameworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectComplianceFrameworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	frameworkID := data.ComplianceFrameworkId.ValueString()
+
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	if err := r.setProjectComplianceFramework(ctx, project.ID, fmt.Sprintf("%q", frameworkID)); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to assign compliance framework %q to project %q: %s", frameworkID, projectID, err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &frameworkID))
+
+	tflog.Debug(ctx, "assigned compliance framework to project", map[string]interface{}{
+		"project": projectID, "compliance_framework_id": frameworkID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectComplianceFrameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectComplianceFrameworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, frameworkID, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<compliance_framework_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "project does not exist, removing compliance framework assignment from state", map[string]interface{}{
+				"project": projectID, "compliance_framework_id": frameworkID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			query {
+				project(fullPath: "%s") {
+					id,
+					complianceFrameworks {
+						nodes {
+							id
+						}
+					}
+				}
+			}`, project.PathWithNamespace),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to retrieve project compliance frameworks", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response projectComplianceFrameworksResponse
+	if _, err := api.SendGraphQLRequest(ctx, r.client, query, &response); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read compliance frameworks of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	// the framework assignment may have been removed or replaced outside of Terraform,
+	// e.g. by removing the compliance label in the project settings.
+	found := false
+	for _, node := range response.Data.Project.ComplianceFrameworks.Nodes {
+		if node.ID == frameworkID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		tflog.Debug(ctx, "compliance framework is no longer assigned to project, removing from state", map[string]interface{}{
+			"project": projectID, "compliance_framework_id": frameworkID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Project = types.StringValue(projectID)
+	data.ComplianceFrameworkId = types.StringValue(frameworkID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is a no-op, because all attributes require a replacement of the resource.
+func (r *gitlabProjectComplianceFrameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Provider Error, report upstream",
+		"Somehow the resource was requested to perform an in-place upgrade which is not possible.",
+	)
+}
+
+// Delete removes the compliance framework from the project.
+func (r *gitlabProjectComplianceFrameworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectComplianceFrameworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, frameworkID, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<compliance_framework_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	if err := r.setProjectComplianceFramework(ctx, project.ID, "null"); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to remove compliance framework %q from project %q: %s", frameworkID, projectID, err.Error()))
+		return
+	}
+}
+
+func (r *gitlabProjectComplianceFrameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setProjectComplianceFramework runs the `projectSetComplianceFramework` mutation.
+// The framework ID must be given as a GraphQL literal, either a quoted global ID or `null` to remove the assignment.
+func (r *gitlabProjectComplianceFrameworkResource) setProjectComplianceFramework(ctx context.Context, projectID int, frameworkID string) error {
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			mutation {
+				projectSetComplianceFramework(
+					input: {
+						projectId: "gid://gitlab/Project/%d",
+						complianceFrameworkId: %s
+					}
+				) {
+					errors
+				}
+			}`, projectID, frameworkID),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to set project compliance framework", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response setProjectComplianceFrameworkResponse
+	if _, err := api.SendGraphQLRequest(ctx, r.client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.ProjectSetComplianceFramework.Errors; len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+type projectComplianceFrameworksResponse struct {
+	Data struct {
+		Project struct {
+			ID                   string `json:"id"`
+			ComplianceFrameworks struct {
+				Nodes []graphQLComplianceFramework `json:"nodes"`
+			} `json:"complianceFrameworks"`
+		} `json:"project"`
+	} `json:"data"`
+}
+
+type setProjectComplianceFrameworkResponse struct {
+	Data struct {
+		ProjectSetComplianceFramework struct {
+			Errors []string `json:"errors"`
+		} `json:"projectSetComplianceFramework"`
+	} `json:"data"`
+}
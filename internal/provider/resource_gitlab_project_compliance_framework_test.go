@@ -0,0 +1,183 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabProjectComplianceFramework_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testProject := testutil.CreateProjectWithNamespace(t, testGroup.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectComplianceFramework_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Assign a compliance framework to the project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_compliance_framework" "one" {
+						namespace_path = "%[1]s"
+						name           = "Framework One"
+						description    = "A test Compliance Framework"
+						color          = "#87BEEF"
+					}
+
+					resource "gitlab_compliance_framework" "two" {
+						namespace_path = "%[1]s"
+						name           = "Framework Two"
+						description    = "Another test Compliance Framework"
+						color          = "#42BEEF"
+					}
+
+					resource "gitlab_project_compliance_framework" "foo" {
+						project                 = "%[2]d"
+						compliance_framework_id = gitlab_compliance_framework.one.framework_id
+					}
+				`, testGroup.FullPath, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("gitlab_project_compliance_framework.foo", "compliance_framework_id", "gitlab_compliance_framework.one", "framework_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_compliance_framework.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the assignment outside of Terraform and detect the drift
+			{
+				PreConfig: func() {
+					query := api.GraphQLQuery{
+						Query: fmt.Sprintf(`
+							mutation {
+								projectSetComplianceFramework(
+									input: {
+										projectId: "gid://gitlab/Project/%d",
+										complianceFrameworkId: null
+									}
+								) {
+									errors
+								}
+							}`, testProject.ID),
+					}
+					if _, err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, query, nil); err != nil {
+						t.Fatalf("failed to remove compliance framework from project: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_compliance_framework" "one" {
+						namespace_path = "%[1]s"
+						name           = "Framework One"
+						description    = "A test Compliance Framework"
+						color          = "#87BEEF"
+					}
+
+					resource "gitlab_compliance_framework" "two" {
+						namespace_path = "%[1]s"
+						name           = "Framework Two"
+						description    = "Another test Compliance Framework"
+						color          = "#42BEEF"
+					}
+
+					resource "gitlab_project_compliance_framework" "foo" {
+						project                 = "%[2]d"
+						compliance_framework_id = gitlab_compliance_framework.one.framework_id
+					}
+				`, testGroup.FullPath, testProject.ID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Assign a different compliance framework to the project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_compliance_framework" "one" {
+						namespace_path = "%[1]s"
+						name           = "Framework One"
+						description    = "A test Compliance Framework"
+						color          = "#87BEEF"
+					}
+
+					resource "gitlab_compliance_framework" "two" {
+						namespace_path = "%[1]s"
+						name           = "Framework Two"
+						description    = "Another test Compliance Framework"
+						color          = "#42BEEF"
+					}
+
+					resource "gitlab_project_compliance_framework" "foo" {
+						project                 = "%[2]d"
+						compliance_framework_id = gitlab_compliance_framework.two.framework_id
+					}
+				`, testGroup.FullPath, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("gitlab_project_compliance_framework.foo", "compliance_framework_id", "gitlab_compliance_framework.two", "framework_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_compliance_framework.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectComplianceFramework_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_compliance_framework" {
+			continue
+		}
+
+		projectID, frameworkID, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed to parse project compliance framework id %q: %w", rs.Primary.ID, err)
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(projectID, nil)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+
+		query := api.GraphQLQuery{
+			Query: fmt.Sprintf(`
+				query {
+					project(fullPath: "%s") {
+						id,
+						complianceFrameworks {
+							nodes {
+								id
+							}
+						}
+					}
+				}`, project.PathWithNamespace),
+		}
+
+		var response projectComplianceFrameworksResponse
+		if _, err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, query, &response); err != nil {
+			return err
+		}
+
+		for _, node := range response.Data.Project.ComplianceFrameworks.Nodes {
+			if node.ID == frameworkID {
+				return fmt.Errorf("Compliance Framework: %s is still assigned to project: %s", frameworkID, projectID)
+			}
+		}
+	}
+	return nil
+}
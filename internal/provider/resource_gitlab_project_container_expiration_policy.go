@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabProjectContainerExpirationPolicyResource{}
+	_ resource.ResourceWithConfigure   = &gitlabProjectContainerExpirationPolicyResource{}
+	_ resource.ResourceWithImportState = &gitlabProjectContainerExpirationPolicyResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectContainerExpirationPolicyResource)
+}
+
+func NewGitLabProjectContainerExpirationPolicyResource() resource.Resource {
+	return &gitlabProjectContainerExpirationPolicyResource{}
+}
+
+type gitlabProjectContainerExpirationPolicyResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectContainerExpirationPolicyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Project         types.String `tfsdk:"project"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Cadence         types.String `tfsdk:"cadence"`
+	KeepN           types.Int64  `tfsdk:"keep_n"`
+	OlderThan       types.String `tfsdk:"older_than"`
+	NameRegexDelete types.String `tfsdk:"name_regex_delete"`
+	NameRegexKeep   types.String `tfsdk:"name_regex_keep"`
+	NextRunAt       types.String `tfsdk:"next_run_at"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectContainerExpirationPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_container_expiration_policy"
+}
+
+func (r *gitlabProjectContainerExpirationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_container_expiration_policy`" + ` resource allows to manage the container expiration policy (cleanup policy) of an existing project.
+
+This allows to manage the policy of projects which are not managed by the same Terraform configuration.
+
+~> Do not use this resource together with the ` + "`container_expiration_policy`" + ` block of the ` + "`gitlab_project`" + ` resource for the same project. They will overwrite each other.
+
+-> Destroying this resource disables the container expiration policy of the project. The other settings of the policy are kept.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#edit-project)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "If true, the policy is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"cadence": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidContainerExpirationPolicyCadenceValues)),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidContainerExpirationPolicyCadenceValues...)},
+			},
+			"keep_n": schema.Int64Attribute{
+				MarkdownDescription: "The number of images to keep.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"older_than": schema.StringAttribute{
+				MarkdownDescription: "The number of days to keep images, e.g. `14d`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name_regex_delete": schema.StringAttribute{
+				MarkdownDescription: "The regular expression to match image names to delete.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name_regex_keep": schema.StringAttribute{
+				MarkdownDescription: "The regular expression to match image names to keep.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"next_run_at": schema.StringAttribute{
+				MarkdownDescription: "The next time the policy will run.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectContainerExpirationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create applies the container expiration policy to the project and adds it into the Terraform state.
+func (r *gitlabProjectContainerExpirationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectContainerExpirationPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	project, _, err := r.client.Projects.EditProject(projectID, &gitlab.EditProjectOptions{
+		ContainerExpirationPolicyAttributes: data.expirationPolicyAttributes(),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update container expiration policy of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.Id = types.StringValue(projectID)
+	data.containerExpirationPolicyToStateModel(project.ContainerExpirationPolicy)
+
+	tflog.Debug(ctx, "updated container expiration policy of project", map[string]interface{}{
+		"project": projectID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectContainerExpirationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectContainerExpirationPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "project does not exist, removing container expiration policy from state", map[string]interface{}{
+				"project": projectID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.Project = types.StringValue(projectID)
+	data.containerExpirationPolicyToStateModel(project.ContainerExpirationPolicy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the container expiration policy in-place.
+func (r *gitlabProjectContainerExpirationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectContainerExpirationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	project, _, err := r.client.Projects.EditProject(projectID, &gitlab.EditProjectOptions{
+		ContainerExpirationPolicyAttributes: data.expirationPolicyAttributes(),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update container expiration policy of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.containerExpirationPolicyToStateModel(project.ContainerExpirationPolicy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete disables the container expiration policy.
+func (r *gitlabProjectContainerExpirationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectContainerExpirationPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	_, _, err := r.client.Projects.EditProject(projectID, &gitlab.EditProjectOptions{
+		ContainerExpirationPolicyAttributes: &gitlab.ContainerExpirationPolicyAttributes{
+			Enabled: gitlab.Bool(false),
+		},
+	}, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to disable container expiration policy of project %q: %s", projectID, err.Error()))
+	}
+}
+
+func (r *gitlabProjectContainerExpirationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expirationPolicyAttributes returns the API options for all configured attributes.
+// Attributes which are not configured are left unchanged by the API.
+func (data *gitlabProjectContainerExpirationPolicyResourceModel) expirationPolicyAttributes() *gitlab.ContainerExpirationPolicyAttributes {
+	attributes := &gitlab.ContainerExpirationPolicyAttributes{}
+	if !data.Enabled.IsUnknown() {
+		attributes.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	if !data.Cadence.IsUnknown() {
+		attributes.Cadence = data.Cadence.ValueStringPointer()
+	}
+	if !data.KeepN.IsUnknown() && !data.KeepN.IsNull() {
+		attributes.KeepN = gitlab.Int(int(data.KeepN.ValueInt64()))
+	}
+	if !data.OlderThan.IsUnknown() {
+		attributes.OlderThan = data.OlderThan.ValueStringPointer()
+	}
+	if !data.NameRegexDelete.IsUnknown() {
+		attributes.NameRegexDelete = data.NameRegexDelete.ValueStringPointer()
+	}
+	if !data.NameRegexKeep.IsUnknown() {
+		attributes.NameRegexKeep = data.NameRegexKeep.ValueStringPointer()
+	}
+	return attributes
+}
+
+func (data *gitlabProjectContainerExpirationPolicyResourceModel) containerExpirationPolicyToStateModel(policy *gitlab.ContainerExpirationPolicy) {
+	if policy == nil {
+		policy = &gitlab.ContainerExpirationPolicy{}
+	}
+
+	data.Enabled = types.BoolValue(policy.Enabled)
+	data.Cadence = types.StringValue(policy.Cadence)
+	data.KeepN = types.Int64Value(int64(policy.KeepN))
+	data.OlderThan = types.StringValue(policy.OlderThan)
+	// The API returns the delete regex as `name_regex`, see the `container_expiration_policy` of the `gitlab_project` resource.
+	data.NameRegexDelete = types.StringValue(policy.NameRegex)
+	data.NameRegexKeep = types.StringValue(policy.NameRegexKeep)
+	data.NextRunAt = types.StringNull()
+	if policy.NextRunAt != nil {
+		data.NextRunAt = types.StringValue(policy.NextRunAt.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,114 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectContainerExpirationPolicy_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectContainerExpirationPolicy_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Enable the policy with defaults for all unset attributes
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_expiration_policy" "this" {
+						project = "%d"
+						enabled = true
+						cadence = "1d"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "cadence", "1d"),
+					resource.TestCheckResourceAttrSet("gitlab_project_container_expiration_policy.this", "keep_n"),
+					resource.TestCheckResourceAttrSet("gitlab_project_container_expiration_policy.this", "next_run_at"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_container_expiration_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update all attributes
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_expiration_policy" "this" {
+						project           = "%d"
+						enabled           = true
+						cadence           = "7d"
+						keep_n            = 5
+						older_than        = "14d"
+						name_regex_delete = ".*"
+						name_regex_keep   = "^(latest|v.+)$"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "cadence", "7d"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "keep_n", "5"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "older_than", "14d"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "name_regex_delete", ".*"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "name_regex_keep", "^(latest|v.+)$"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_container_expiration_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Disable the policy outside of Terraform and detect the drift
+			{
+				PreConfig: func() {
+					_, _, err := testutil.TestGitlabClient.Projects.EditProject(testProject.ID, &gitlab.EditProjectOptions{
+						ContainerExpirationPolicyAttributes: &gitlab.ContainerExpirationPolicyAttributes{Enabled: gitlab.Bool(false)},
+					})
+					if err != nil {
+						t.Fatalf("failed to disable container expiration policy: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_expiration_policy" "this" {
+						project           = "%d"
+						enabled           = true
+						cadence           = "7d"
+						keep_n            = 5
+						older_than        = "14d"
+						name_regex_delete = ".*"
+						name_regex_keep   = "^(latest|v.+)$"
+					}
+				`, testProject.ID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectContainerExpirationPolicy_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_container_expiration_policy" {
+			continue
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if project.ContainerExpirationPolicy != nil && project.ContainerExpirationPolicy.Enabled {
+			return fmt.Errorf("container expiration policy of project %s is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
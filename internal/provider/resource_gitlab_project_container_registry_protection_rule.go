@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &gitlabProjectContainerRegistryProtectionRuleResource{}
+	_ resource.ResourceWithConfigure      = &gitlabProjectContainerRegistryProtectionRuleResource{}
+	_ resource.ResourceWithImportState    = &gitlabProjectContainerRegistryProtectionRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &gitlabProjectContainerRegistryProtectionRuleResource{}
+	_ resource.ResourceWithValidateConfig = &gitlabProjectContainerRegistryProtectionRuleResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectContainerRegistryProtectionRuleResource)
+}
+
+func NewGitLabProjectContainerRegistryProtectionRuleResource() resource.Resource {
+	return &gitlabProjectContainerRegistryProtectionRuleResource{}
+}
+
+type gitlabProjectContainerRegistryProtectionRuleResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectContainerRegistryProtectionRuleResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Project                     types.String `tfsdk:"project"`
+	ProtectionRuleId            types.Int64  `tfsdk:"protection_rule_id"`
+	RepositoryPathPattern       types.String `tfsdk:"repository_path_pattern"`
+	MinimumAccessLevelForPush   types.String `tfsdk:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete types.String `tfsdk:"minimum_access_level_for_delete"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_container_registry_protection_rule"
+}
+
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_container_registry_protection_rule`" + ` resource allows to manage the lifecycle of a container registry protection rule of a project.
+
+A protection rule restricts which users can push or delete container images in repositories matching the ` + "`repository_path_pattern`" + `.
+
+-> The container expiration policy of a project, configured in the ` + "`container_expiration_policy`" + ` block of the ` + "`gitlab_project`" + ` resource, is managed separately.
+   When it is enabled and may delete tags of protected repositories, this resource only shows a warning during planning.
+   The conflict is neither prevented nor reported by the ` + "`gitlab_project`" + ` resource, so ensure the ` + "`name_regex_keep`" + ` of the policy keeps the protected images.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/container_repository_protection_rules.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<protection_rule_id>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"protection_rule_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the protection rule.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"repository_path_pattern": schema.StringAttribute{
+				MarkdownDescription: "The path of the container repositories to protect, including the project path. Supports the `*` wildcard, e.g. `my-group/my-project/*`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"minimum_access_level_for_push": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The minimum access level required to push container images. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidRegistryProtectionRuleAccessLevelNames)),
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidRegistryProtectionRuleAccessLevelNames...)},
+			},
+			"minimum_access_level_for_delete": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The minimum access level required to delete container images. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidRegistryProtectionRuleAccessLevelNames)),
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidRegistryProtectionRuleAccessLevelNames...)},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that at least one of the minimum access levels is configured.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MinimumAccessLevelForPush.IsNull() && data.MinimumAccessLevelForDelete.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minimum_access_level_for_push"),
+			"Missing minimum access level",
+			"At least one of `minimum_access_level_for_push` and `minimum_access_level_for_delete` must be configured.",
+		)
+	}
+}
+
+// ModifyPlan cross-validates the protection rule against the container expiration policy of the project.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Project.IsUnknown() || data.MinimumAccessLevelForDelete.IsNull() {
+		return
+	}
+
+	// The project may not exist yet, e.g. when it is created in the same run. There is nothing to validate against then.
+	project, _, err := r.client.Projects.GetProject(data.Project.ValueString(), nil, gitlab.WithContext(ctx))
+	if err != nil || project.ContainerExpirationPolicy == nil {
+		return
+	}
+
+	if warning := containerExpirationPolicyProtectionWarning(project.ContainerExpirationPolicy); warning != "" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("minimum_access_level_for_delete"),
+			"Container expiration policy may delete protected images",
+			fmt.Sprintf("The container expiration policy of project %q %s. Protection rules restrict which users can delete images, but the cleanup policy runs independently of them. Adjust `name_regex_keep` in the `container_expiration_policy` of the project to keep images matching %q.", project.PathWithNamespace, warning, data.RepositoryPathPattern.ValueString()),
+		)
+	}
+}
+
+// containerExpirationPolicyProtectionWarning returns a description of why the enabled cleanup policy may delete tags
+// which the user intends to protect, or an empty string if the cleanup policy keeps all tags.
+func containerExpirationPolicyProtectionWarning(policy *gitlab.ContainerExpirationPolicy) string {
+	// The API returns the delete regex as `name_regex`.
+	nameRegexDelete := policy.NameRegex
+	if nameRegexDelete == "" {
+		nameRegexDelete = policy.NameRegexDelete
+	}
+	if !policy.Enabled || nameRegexDelete == "" {
+		return ""
+	}
+	if policy.NameRegexKeep == "" {
+		return fmt.Sprintf("deletes all tags matching `%s` and keeps none", nameRegexDelete)
+	}
+	// GitLab keeps the `latest` tag only if it matches the keep regex, which is the most common tag to protect.
+	keep, err := regexp.Compile(policy.NameRegexKeep)
+	if err == nil && !keep.MatchString("latest") {
+		return fmt.Sprintf("deletes tags matching `%s` and its `name_regex_keep` (`%s`) does not keep the `latest` tag", nameRegexDelete, policy.NameRegexKeep)
+	}
+	return ""
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new upstream resource and adds it into the Terraform state.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	options := &containerRegistryProtectionRuleOptions{
+		RepositoryPathPattern:       data.RepositoryPathPattern.ValueString(),
+		MinimumAccessLevelForPush:   data.MinimumAccessLevelForPush.ValueStringPointer(),
+		MinimumAccessLevelForDelete: data.MinimumAccessLevelForDelete.ValueStringPointer(),
+	}
+
+	var rule containerRegistryProtectionRule
+	err := func() error {
+		req, err := r.client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(projectID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, &rule)
+		return err
+	}()
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create container registry protection rule: %s", err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	ruleID := strconv.Itoa(rule.ID)
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &ruleID))
+	data.containerRegistryProtectionRuleToStateModel(projectID, &rule)
+
+	tflog.Debug(ctx, "created a container registry protection rule", map[string]interface{}{
+		"project": projectID, "protection_rule_id": rule.ID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// there is no API to get a single protection rule, therefore we have to search all rules of the project.
+	var rule *containerRegistryProtectionRule
+	options := &gitlab.ListOptions{PerPage: 100, Page: 1}
+	for options.Page != 0 && rule == nil {
+		var rules []*containerRegistryProtectionRule
+		req, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(projectID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read container registry protection rules: %s", err.Error()))
+			return
+		}
+		apiResp, err := r.client.Do(req, &rules)
+		if err != nil {
+			if api.Is404(err) {
+				tflog.Debug(ctx, "project does not exist, removing container registry protection rule from state", map[string]interface{}{
+					"project": projectID, "protection_rule_id": ruleID,
+				})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read container registry protection rules: %s", err.Error()))
+			return
+		}
+
+		for _, candidate := range rules {
+			if candidate.ID == ruleID {
+				rule = candidate
+				break
+			}
+		}
+		options.Page = apiResp.NextPage
+	}
+
+	if rule == nil {
+		tflog.Debug(ctx, "container registry protection rule does not exist, removing from state", map[string]interface{}{
+			"project": projectID, "protection_rule_id": ruleID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.containerRegistryProtectionRuleToStateModel(projectID, rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// unset access levels are sent as `null` to remove them from the rule
+	options := &containerRegistryProtectionRuleOptions{
+		RepositoryPathPattern:       data.RepositoryPathPattern.ValueString(),
+		MinimumAccessLevelForPush:   data.MinimumAccessLevelForPush.ValueStringPointer(),
+		MinimumAccessLevelForDelete: data.MinimumAccessLevelForDelete.ValueStringPointer(),
+	}
+
+	var rule containerRegistryProtectionRule
+	err = func() error {
+		req, err := r.client.NewRequest(http.MethodPatch, fmt.Sprintf("projects/%s/registry/protection/repository/rules/%d", gitlab.PathEscape(projectID), ruleID), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, &rule)
+		return err
+	}()
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update container registry protection rule: %s", err.Error()))
+		return
+	}
+
+	data.containerRegistryProtectionRuleToStateModel(projectID, &rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectContainerRegistryProtectionRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = func() error {
+		req, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("projects/%s/registry/protection/repository/rules/%d", gitlab.PathEscape(projectID), ruleID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, nil)
+		return err
+	}()
+	if err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete container registry protection rule: %s", err.Error()))
+	}
+}
+
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *gitlabProjectContainerRegistryProtectionRuleResource) parseID(id string) (string, int, error) {
+	projectID, rawRuleID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+	ruleID, err := strconv.Atoi(rawRuleID)
+	if err != nil {
+		return "", 0, err
+	}
+	return projectID, ruleID, nil
+}
+
+func (data *gitlabProjectContainerRegistryProtectionRuleResourceModel) containerRegistryProtectionRuleToStateModel(projectID string, rule *containerRegistryProtectionRule) {
+	data.Project = types.StringValue(projectID)
+	data.ProtectionRuleId = types.Int64Value(int64(rule.ID))
+	data.RepositoryPathPattern = types.StringValue(rule.RepositoryPathPattern)
+	data.MinimumAccessLevelForPush = types.StringPointerValue(rule.MinimumAccessLevelForPush)
+	data.MinimumAccessLevelForDelete = types.StringPointerValue(rule.MinimumAccessLevelForDelete)
+}
+
+// containerRegistryProtectionRule is the API representation of a container registry protection rule.
+// The endpoints are not yet available in go-gitlab.
+type containerRegistryProtectionRule struct {
+	ID                          int     `json:"id"`
+	ProjectID                   int     `json:"project_id"`
+	RepositoryPathPattern       string  `json:"repository_path_pattern"`
+	MinimumAccessLevelForPush   *string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete *string `json:"minimum_access_level_for_delete"`
+}
+
+type containerRegistryProtectionRuleOptions struct {
+	RepositoryPathPattern       string  `json:"repository_path_pattern"`
+	MinimumAccessLevelForPush   *string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete *string `json:"minimum_access_level_for_delete"`
+}
@@ -0,0 +1,144 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabProjectContainerRegistryProtectionRule_basic(t *testing.T) {
+	testutil.RunIfAtLeast(t, "16.6")
+
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectContainerRegistryProtectionRule_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create a protection rule with only the push access level
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                       = "%[1]d"
+						repository_path_pattern       = "%[2]s/*"
+						minimum_access_level_for_push = "maintainer"
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_container_registry_protection_rule.this", "protection_rule_id"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_push", "maintainer"),
+					resource.TestCheckNoResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_delete"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_container_registry_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the pattern and access levels in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                         = "%[1]d"
+						repository_path_pattern         = "%[2]s/release-*"
+						minimum_access_level_for_push   = "owner"
+						minimum_access_level_for_delete = "admin"
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "repository_path_pattern", fmt.Sprintf("%s/release-*", testProject.PathWithNamespace)),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_push", "owner"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_delete", "admin"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_container_registry_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectContainerRegistryProtectionRule_withContainerExpirationPolicy(t *testing.T) {
+	testutil.RunIfAtLeast(t, "16.6")
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6MuxProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectContainerRegistryProtectionRule_CheckDestroy,
+		Steps: []resource.TestStep{
+			// The expiration policy is managed by the project and the protection rule is managed separately
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name         = "registry-protection"
+						namespace_id = %d
+
+						container_expiration_policy {
+							enabled           = true
+							cadence           = "1d"
+							name_regex_delete = ".*"
+							name_regex_keep   = "^(latest|v.+)$"
+						}
+					}
+
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                         = gitlab_project.this.id
+						repository_path_pattern         = "${gitlab_project.this.path_with_namespace}/*"
+						minimum_access_level_for_delete = "maintainer"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_delete", "maintainer"),
+				),
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectContainerRegistryProtectionRule_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_container_registry_protection_rule" {
+			continue
+		}
+
+		project, rawRuleID, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ruleID, err := strconv.Atoi(rawRuleID)
+		if err != nil {
+			return err
+		}
+
+		req, err := testutil.TestGitlabClient.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(project)), &gitlab.ListOptions{PerPage: 100}, nil)
+		if err != nil {
+			return err
+		}
+		var rules []*containerRegistryProtectionRule
+		if _, err := testutil.TestGitlabClient.Do(req, &rules); err != nil {
+			// the project has been deleted, which also deletes the rules
+			continue
+		}
+		for _, rule := range rules {
+			if rule.ID == ruleID {
+				return fmt.Errorf("container registry protection rule %d in project %s still exists", ruleID, project)
+			}
+		}
+	}
+	return nil
+}
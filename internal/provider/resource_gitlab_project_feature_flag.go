@@ -0,0 +1,617 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &gitlabProjectFeatureFlagResource{}
+	_ resource.ResourceWithConfigure      = &gitlabProjectFeatureFlagResource{}
+	_ resource.ResourceWithImportState    = &gitlabProjectFeatureFlagResource{}
+	_ resource.ResourceWithValidateConfig = &gitlabProjectFeatureFlagResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectFeatureFlagResource)
+}
+
+func NewGitLabProjectFeatureFlagResource() resource.Resource {
+	return &gitlabProjectFeatureFlagResource{}
+}
+
+var validFeatureFlagStrategyNames = []string{"default", "gradualRolloutUserId", "flexibleRollout", "userWithId", "gitlabUserList"}
+
+var validFeatureFlagStickinessValues = []string{"default", "userId", "sessionId", "random"}
+
+// The group ID GitLab uses for rollout strategies created in the UI.
+const defaultFeatureFlagStrategyGroupID = "default"
+
+type gitlabProjectFeatureFlagResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectFeatureFlagResourceModel struct {
+	Id          types.String                            `tfsdk:"id"`
+	Project     types.String                            `tfsdk:"project"`
+	Name        types.String                            `tfsdk:"name"`
+	Description types.String                            `tfsdk:"description"`
+	Active      types.Bool                              `tfsdk:"active"`
+	Strategies  []gitlabProjectFeatureFlagStrategyModel `tfsdk:"strategy"`
+}
+
+type gitlabProjectFeatureFlagStrategyModel struct {
+	Name              types.String   `tfsdk:"name"`
+	Percentage        types.Int64    `tfsdk:"percentage"`
+	Stickiness        types.String   `tfsdk:"stickiness"`
+	GroupId           types.String   `tfsdk:"group_id"`
+	UserIds           []types.String `tfsdk:"user_ids"`
+	UserListId        types.Int64    `tfsdk:"user_list_id"`
+	EnvironmentScopes []types.String `tfsdk:"environment_scopes"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectFeatureFlagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_feature_flag"
+}
+
+func (r *gitlabProjectFeatureFlagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_feature_flag`" + ` resource allows to manage the lifecycle of a feature flag of a project.
+
+-> The environment scopes of the strategies are validated against the environments of the project when the feature flag is created or updated.
+   Manage the environments with the ` + "`gitlab_project_environment`" + ` resource and reference their ` + "`name`" + ` to create them first.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/feature_flags.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<name>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feature flag.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the feature flag.",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feature flag is active. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"strategy": schema.ListNestedBlock{
+				MarkdownDescription: "The strategies of the feature flag. A feature flag without strategies is disabled in all environments.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The name of the strategy. Valid values are: %s.", utils.RenderValueListForDocs(validFeatureFlagStrategyNames)),
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.OneOf(validFeatureFlagStrategyNames...)},
+						},
+						"percentage": schema.Int64Attribute{
+							MarkdownDescription: "The percentage of users to enable the feature flag for. Required for the `gradualRolloutUserId` and `flexibleRollout` strategies.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.Between(0, 100)},
+						},
+						"stickiness": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The stickiness of the `flexibleRollout` strategy. Valid values are: %s.", utils.RenderValueListForDocs(validFeatureFlagStickinessValues)),
+							Optional:            true,
+							Validators:          []validator.String{stringvalidator.OneOf(validFeatureFlagStickinessValues...)},
+						},
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The group ID of the `gradualRolloutUserId` and `flexibleRollout` strategies. Users are assigned to the same rollout group across flags with the same group ID. Defaults to `%s` if not set.", defaultFeatureFlagStrategyGroupID),
+							Optional:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"user_ids": schema.ListAttribute{
+							MarkdownDescription: "The user IDs to enable the feature flag for. Required for the `userWithId` strategy.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.RegexMatches(userListXidRegex, "must not contain commas or whitespace")),
+							},
+						},
+						"user_list_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the user list to enable the feature flag for. Required for the `gitlabUserList` strategy. Use the `user_list_id` attribute of the `gitlab_project_feature_flag_user_list` resource.",
+							Optional:            true,
+						},
+						"environment_scopes": schema.SetAttribute{
+							MarkdownDescription: "The environment scopes the strategy applies to, e.g. `production` or `review/*`. Use `*` for all environments.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that each strategy only configures the parameters it supports.
+func (r *gitlabProjectFeatureFlagResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *gitlabProjectFeatureFlagResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, strategy := range data.Strategies {
+		if strategy.Name.IsUnknown() {
+			continue
+		}
+		name := strategy.Name.ValueString()
+
+		// the parameters supported by each strategy, all of them are required except the group id.
+		parameters := map[string]bool{
+			"percentage":   !strategy.Percentage.IsNull(),
+			"stickiness":   !strategy.Stickiness.IsNull(),
+			"group_id":     !strategy.GroupId.IsNull(),
+			"user_ids":     strategy.UserIds != nil,
+			"user_list_id": !strategy.UserListId.IsNull(),
+		}
+		supported := map[string][]string{
+			"default":              {},
+			"gradualRolloutUserId": {"percentage", "group_id"},
+			"flexibleRollout":      {"percentage", "stickiness", "group_id"},
+			"userWithId":           {"user_ids"},
+			"gitlabUserList":       {"user_list_id"},
+		}[name]
+
+		for _, parameter := range []string{"percentage", "stickiness", "group_id", "user_ids", "user_list_id"} {
+			isSet := parameters[parameter]
+			isSupported := false
+			for _, s := range supported {
+				if s == parameter {
+					isSupported = true
+				}
+			}
+
+			if isSet && !isSupported {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("strategy").AtListIndex(i).AtName(parameter),
+					"Unsupported strategy parameter",
+					fmt.Sprintf("The `%s` attribute is not supported by the `%s` strategy.", parameter, name),
+				)
+			}
+			if !isSet && isSupported && parameter != "group_id" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("strategy").AtListIndex(i).AtName(parameter),
+					"Missing strategy parameter",
+					fmt.Sprintf("The `%s` attribute is required by the `%s` strategy.", parameter, name),
+				)
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectFeatureFlagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new upstream resource and adds it into the Terraform state.
+func (r *gitlabProjectFeatureFlagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectFeatureFlagResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	if err := r.validateEnvironmentScopes(ctx, projectID, data.Strategies); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("strategy"), "Invalid environment scope", err.Error())
+		return
+	}
+
+	options := &projectFeatureFlagOptions{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Version:     "new_version_flag",
+		Active:      data.Active.ValueBool(),
+		Strategies:  featureFlagStrategyOptions(data.Strategies, nil),
+	}
+
+	var featureFlag projectFeatureFlag
+	if err := r.send(ctx, http.MethodPost, fmt.Sprintf("projects/%s/feature_flags", gitlab.PathEscape(projectID)), options, &featureFlag); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create feature flag: %s", err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &featureFlag.Name))
+	data.featureFlagToStateModel(projectID, &featureFlag)
+
+	tflog.Debug(ctx, "created a feature flag", map[string]interface{}{
+		"project": projectID, "name": featureFlag.Name,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectFeatureFlagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectFeatureFlagResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, name, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	featureFlag, err := r.read(ctx, projectID, name)
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "feature flag does not exist, removing from state", map[string]interface{}{
+				"project": projectID, "name": name,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature flag: %s", err.Error()))
+		return
+	}
+
+	data.featureFlagToStateModel(projectID, featureFlag)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabProjectFeatureFlagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectFeatureFlagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, name, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if err := r.validateEnvironmentScopes(ctx, projectID, data.Strategies); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("strategy"), "Invalid environment scope", err.Error())
+		return
+	}
+
+	// the existing strategies and scopes are required to update them in-place and remove the ones no longer configured.
+	existing, err := r.read(ctx, projectID, name)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature flag: %s", err.Error()))
+		return
+	}
+
+	description := data.Description.ValueString()
+	options := &projectFeatureFlagOptions{
+		Description: &description,
+		Active:      data.Active.ValueBool(),
+		Strategies:  featureFlagStrategyOptions(data.Strategies, existing.Strategies),
+	}
+
+	var featureFlag projectFeatureFlag
+	if err := r.send(ctx, http.MethodPut, fmt.Sprintf("projects/%s/feature_flags/%s", gitlab.PathEscape(projectID), gitlab.PathEscape(name)), options, &featureFlag); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update feature flag: %s", err.Error()))
+		return
+	}
+
+	data.featureFlagToStateModel(projectID, &featureFlag)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabProjectFeatureFlagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectFeatureFlagResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, name, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if _, err := r.client.ProjectFeatureFlags.DeleteProjectFeatureFlag(projectID, name, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete feature flag: %s", err.Error()))
+	}
+}
+
+func (r *gitlabProjectFeatureFlagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read returns the feature flag. The go-gitlab feature flag types don't expose the user list of a strategy,
+// therefore the request is sent directly.
+func (r *gitlabProjectFeatureFlagResource) read(ctx context.Context, projectID string, name string) (*projectFeatureFlag, error) {
+	var featureFlag projectFeatureFlag
+	if err := r.send(ctx, http.MethodGet, fmt.Sprintf("projects/%s/feature_flags/%s", gitlab.PathEscape(projectID), gitlab.PathEscape(name)), nil, &featureFlag); err != nil {
+		return nil, err
+	}
+	return &featureFlag, nil
+}
+
+func (r *gitlabProjectFeatureFlagResource) send(ctx context.Context, method string, path string, options interface{}, v interface{}) error {
+	req, err := r.client.NewRequest(method, path, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Do(req, v)
+	return err
+}
+
+// validateEnvironmentScopes ensures that every environment scope of the strategies matches at least one environment of the project.
+func (r *gitlabProjectFeatureFlagResource) validateEnvironmentScopes(ctx context.Context, projectID string, strategies []gitlabProjectFeatureFlagStrategyModel) error {
+	var environments []string
+	options := &gitlab.ListEnvironmentsOptions{ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1}}
+	for options.Page != 0 {
+		paginatedEnvironments, resp, err := r.client.Environments.ListEnvironments(projectID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("unable to list environments of project %q: %w", projectID, err)
+		}
+		for _, environment := range paginatedEnvironments {
+			environments = append(environments, environment.Name)
+		}
+		options.Page = resp.NextPage
+	}
+
+	for _, strategy := range strategies {
+		for _, scope := range strategy.EnvironmentScopes {
+			if !featureFlagEnvironmentScopeMatchesAny(scope.ValueString(), environments) {
+				return fmt.Errorf("the environment scope %q of the %q strategy does not match any environment of project %q", scope.ValueString(), strategy.Name.ValueString(), projectID)
+			}
+		}
+	}
+	return nil
+}
+
+// featureFlagEnvironmentScopeMatchesAny returns true if the scope, which may contain `*` wildcards, matches one of the environment names.
+func featureFlagEnvironmentScopeMatchesAny(scope string, environments []string) bool {
+	if scope == "*" {
+		return true
+	}
+
+	pattern := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(scope), `\*`, ".*") + "$")
+	for _, environment := range environments {
+		if pattern.MatchString(environment) {
+			return true
+		}
+	}
+	return false
+}
+
+// featureFlagStrategyOptions builds the strategies to send to the API.
+// Existing strategies are updated in-place by their position, surplus existing strategies and scopes are removed.
+func featureFlagStrategyOptions(strategies []gitlabProjectFeatureFlagStrategyModel, existing []*projectFeatureFlagStrategy) []*projectFeatureFlagStrategyOptions {
+	options := []*projectFeatureFlagStrategyOptions{}
+	for i, strategy := range strategies {
+		option := &projectFeatureFlagStrategyOptions{
+			Name:       strategy.Name.ValueString(),
+			Parameters: map[string]string{},
+			Scopes:     []*projectFeatureFlagScopeOptions{},
+		}
+
+		groupID := defaultFeatureFlagStrategyGroupID
+		if !strategy.GroupId.IsNull() {
+			groupID = strategy.GroupId.ValueString()
+		}
+		switch option.Name {
+		case "gradualRolloutUserId":
+			option.Parameters["groupId"] = groupID
+			option.Parameters["percentage"] = strconv.FormatInt(strategy.Percentage.ValueInt64(), 10)
+		case "flexibleRollout":
+			option.Parameters["groupId"] = groupID
+			option.Parameters["rollout"] = strconv.FormatInt(strategy.Percentage.ValueInt64(), 10)
+			option.Parameters["stickiness"] = strategy.Stickiness.ValueString()
+		case "userWithId":
+			userIDs := make([]string, 0, len(strategy.UserIds))
+			for _, userID := range strategy.UserIds {
+				userIDs = append(userIDs, userID.ValueString())
+			}
+			option.Parameters["userIds"] = strings.Join(userIDs, ",")
+		case "gitlabUserList":
+			option.UserListID = int(strategy.UserListId.ValueInt64())
+		}
+
+		configuredScopes := map[string]bool{}
+		for _, scope := range strategy.EnvironmentScopes {
+			configuredScopes[scope.ValueString()] = true
+		}
+
+		if i < len(existing) {
+			option.ID = existing[i].ID
+			for _, scope := range existing[i].Scopes {
+				if configuredScopes[scope.EnvironmentScope] {
+					delete(configuredScopes, scope.EnvironmentScope)
+					continue
+				}
+				option.Scopes = append(option.Scopes, &projectFeatureFlagScopeOptions{ID: scope.ID, Destroy: true})
+			}
+		}
+		for _, scope := range strategy.EnvironmentScopes {
+			if configuredScopes[scope.ValueString()] {
+				option.Scopes = append(option.Scopes, &projectFeatureFlagScopeOptions{EnvironmentScope: scope.ValueString()})
+			}
+		}
+
+		options = append(options, option)
+	}
+
+	for i := len(strategies); i < len(existing); i++ {
+		options = append(options, &projectFeatureFlagStrategyOptions{ID: existing[i].ID, Destroy: true})
+	}
+	return options
+}
+
+func (data *gitlabProjectFeatureFlagResourceModel) featureFlagToStateModel(projectID string, featureFlag *projectFeatureFlag) {
+	data.Project = types.StringValue(projectID)
+	data.Name = types.StringValue(featureFlag.Name)
+	data.Active = types.BoolValue(featureFlag.Active)
+	data.Description = types.StringNull()
+	if featureFlag.Description != "" {
+		data.Description = types.StringValue(featureFlag.Description)
+	}
+
+	priorStrategies := data.Strategies
+	data.Strategies = nil
+	for i, strategy := range featureFlag.Strategies {
+		model := gitlabProjectFeatureFlagStrategyModel{
+			Name:       types.StringValue(strategy.Name),
+			Percentage: types.Int64Null(),
+			Stickiness: types.StringNull(),
+			GroupId:    types.StringNull(),
+			UserListId: types.Int64Null(),
+		}
+
+		switch strategy.Name {
+		case "gradualRolloutUserId", "flexibleRollout":
+			percentage := strategy.Parameters["percentage"]
+			if strategy.Name == "flexibleRollout" {
+				percentage = strategy.Parameters["rollout"]
+				model.Stickiness = types.StringValue(strategy.Parameters["stickiness"])
+			}
+			if v, err := strconv.ParseInt(percentage, 10, 64); err == nil {
+				model.Percentage = types.Int64Value(v)
+			}
+
+			// keep the group id unset if the default is used and it hasn't been configured explicitly.
+			groupID := strategy.Parameters["groupId"]
+			priorGroupIDUnset := i >= len(priorStrategies) || priorStrategies[i].GroupId.IsNull()
+			if groupID != defaultFeatureFlagStrategyGroupID || !priorGroupIDUnset {
+				model.GroupId = types.StringValue(groupID)
+			}
+		case "userWithId":
+			for _, userID := range strings.Split(strategy.Parameters["userIds"], ",") {
+				if userID != "" {
+					model.UserIds = append(model.UserIds, types.StringValue(userID))
+				}
+			}
+		case "gitlabUserList":
+			if strategy.UserList != nil {
+				model.UserListId = types.Int64Value(int64(strategy.UserList.ID))
+			}
+		}
+
+		for _, scope := range strategy.Scopes {
+			model.EnvironmentScopes = append(model.EnvironmentScopes, types.StringValue(scope.EnvironmentScope))
+		}
+
+		data.Strategies = append(data.Strategies, model)
+	}
+}
+
+// projectFeatureFlag is the API representation of a feature flag including the user list of the strategies,
+// which is not available in go-gitlab.
+type projectFeatureFlag struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Active      bool                          `json:"active"`
+	Strategies  []*projectFeatureFlagStrategy `json:"strategies"`
+}
+
+type projectFeatureFlagStrategy struct {
+	ID         int                               `json:"id"`
+	Name       string                            `json:"name"`
+	Parameters map[string]string                 `json:"parameters"`
+	Scopes     []*gitlab.ProjectFeatureFlagScope `json:"scopes"`
+	UserList   *struct {
+		ID int `json:"id"`
+	} `json:"user_list"`
+}
+
+type projectFeatureFlagOptions struct {
+	Name        string                               `json:"name,omitempty"`
+	Description *string                              `json:"description,omitempty"`
+	Version     string                               `json:"version,omitempty"`
+	Active      bool                                 `json:"active"`
+	Strategies  []*projectFeatureFlagStrategyOptions `json:"strategies"`
+}
+
+type projectFeatureFlagStrategyOptions struct {
+	ID         int                               `json:"id,omitempty"`
+	Name       string                            `json:"name,omitempty"`
+	Parameters map[string]string                 `json:"parameters,omitempty"`
+	UserListID int                               `json:"user_list_id,omitempty"`
+	Scopes     []*projectFeatureFlagScopeOptions `json:"scopes,omitempty"`
+	Destroy    bool                              `json:"_destroy,omitempty"`
+}
+
+type projectFeatureFlagScopeOptions struct {
+	ID               int    `json:"id,omitempty"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+	Destroy          bool   `json:"_destroy,omitempty"`
+}
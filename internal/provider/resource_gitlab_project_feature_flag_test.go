@@ -0,0 +1,196 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabProjectFeatureFlag_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testutil.CreateProjectEnvironment(t, testProject.ID, &gitlab.CreateEnvironmentOptions{Name: gitlab.String("production")})
+	testutil.CreateProjectEnvironment(t, testProject.ID, &gitlab.CreateEnvironmentOptions{Name: gitlab.String("review/feature")})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectFeatureFlag_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create a feature flag with a single strategy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag" "this" {
+						project = "%d"
+						name    = "new_checkout"
+
+						strategy {
+							name               = "default"
+							environment_scopes = ["production"]
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.0.environment_scopes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_feature_flag.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the strategies and scopes in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag" "this" {
+						project     = "%d"
+						name        = "new_checkout"
+						description = "The new checkout flow"
+						active      = false
+
+						strategy {
+							name               = "flexibleRollout"
+							percentage         = 25
+							stickiness         = "userId"
+							environment_scopes = ["production", "review/*"]
+						}
+
+						strategy {
+							name               = "userWithId"
+							user_ids           = ["alice", "bob"]
+							environment_scopes = ["*"]
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "description", "The new checkout flow"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "active", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.0.percentage", "25"),
+					resource.TestCheckNoResourceAttr("gitlab_project_feature_flag.this", "strategy.0.group_id"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.0.environment_scopes.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.1.user_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_feature_flag.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the default group id is only kept unset in the state if it has not been configured before.
+				ImportStateVerifyIgnore: []string{"strategy.0.group_id"},
+			},
+			// Remove a strategy and use a user list
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = "%[1]d"
+						name      = "beta-testers"
+						user_xids = ["alice", "bob"]
+					}
+
+					resource "gitlab_project_feature_flag" "this" {
+						project = "%[1]d"
+						name    = "new_checkout"
+
+						strategy {
+							name               = "gitlabUserList"
+							user_list_id       = gitlab_project_feature_flag_user_list.this.user_list_id
+							environment_scopes = ["production"]
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.#", "1"),
+					resource.TestCheckResourceAttrPair("gitlab_project_feature_flag.this", "strategy.0.user_list_id", "gitlab_project_feature_flag_user_list.this", "user_list_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_feature_flag.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectFeatureFlag_invalidEnvironmentScope(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testutil.CreateProjectEnvironment(t, testProject.ID, &gitlab.CreateEnvironmentOptions{Name: gitlab.String("production")})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectFeatureFlag_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag" "this" {
+						project = "%d"
+						name    = "new_checkout"
+
+						strategy {
+							name               = "default"
+							environment_scopes = ["staging"]
+						}
+					}
+				`, testProject.ID),
+				ExpectError: regexp.MustCompile(`does not match any environment`),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectFeatureFlag_missingStrategyParameter(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_project_feature_flag" "this" {
+						project = "123"
+						name    = "new_checkout"
+
+						strategy {
+							name               = "gradualRolloutUserId"
+							stickiness         = "random"
+							environment_scopes = ["*"]
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`(The .percentage. attribute is required by the .gradualRolloutUserId. strategy|The .stickiness. attribute is not supported)`),
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectFeatureFlag_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_feature_flag" {
+			continue
+		}
+
+		project, name, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testutil.TestGitlabClient.ProjectFeatureFlags.GetProjectFeatureFlag(project, name)
+		if err == nil {
+			return fmt.Errorf("feature flag %s in project %s still exists", name, project)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabProjectFeatureFlagUserListResource{}
+	_ resource.ResourceWithConfigure   = &gitlabProjectFeatureFlagUserListResource{}
+	_ resource.ResourceWithImportState = &gitlabProjectFeatureFlagUserListResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectFeatureFlagUserListResource)
+}
+
+func NewGitLabProjectFeatureFlagUserListResource() resource.Resource {
+	return &gitlabProjectFeatureFlagUserListResource{}
+}
+
+type gitlabProjectFeatureFlagUserListResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectFeatureFlagUserListResourceModel struct {
+	Id         types.String   `tfsdk:"id"`
+	Project    types.String   `tfsdk:"project"`
+	Name       types.String   `tfsdk:"name"`
+	UserXids   []types.String `tfsdk:"user_xids"`
+	Iid        types.Int64    `tfsdk:"iid"`
+	UserListId types.Int64    `tfsdk:"user_list_id"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectFeatureFlagUserListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_feature_flag_user_list"
+}
+
+func (r *gitlabProjectFeatureFlagUserListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_feature_flag_user_list`" + ` resource allows to manage the lifecycle of a feature flag user list of a project.
+
+A user list can be used in a ` + "`gitlabUserList`" + ` strategy of the ` + "`gitlab_project_feature_flag`" + ` resource.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/feature_flag_user_lists.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<iid>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user list.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"user_xids": schema.ListAttribute{
+				MarkdownDescription: "The external user IDs of the users in the list. These are the IDs your application passes to the feature flag client, not GitLab user IDs.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1), stringvalidator.RegexMatches(userListXidRegex, "must not contain commas or whitespace")),
+				},
+			},
+			"iid": schema.Int64Attribute{
+				MarkdownDescription: "The internal ID of the user list within the project.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"user_list_id": schema.Int64Attribute{
+				MarkdownDescription: "The global ID of the user list. Use this value for `user_list_id` in a `gitlabUserList` strategy of the `gitlab_project_feature_flag` resource.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectFeatureFlagUserListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new upstream resource and adds it into the Terraform state.
+func (r *gitlabProjectFeatureFlagUserListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectFeatureFlagUserListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	var userList featureFlagUserList
+	if err := r.send(ctx, http.MethodPost, fmt.Sprintf("projects/%s/feature_flags_user_lists", gitlab.PathEscape(projectID)), data.userListOptions(), &userList); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create feature flag user list: %s", err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	iid := strconv.Itoa(userList.IID)
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &iid))
+	data.userListToStateModel(projectID, &userList)
+
+	tflog.Debug(ctx, "created a feature flag user list", map[string]interface{}{
+		"project": projectID, "iid": userList.IID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectFeatureFlagUserListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectFeatureFlagUserListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, iid, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<iid>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var userList featureFlagUserList
+	if err := r.send(ctx, http.MethodGet, fmt.Sprintf("projects/%s/feature_flags_user_lists/%s", gitlab.PathEscape(projectID), iid), nil, &userList); err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "feature flag user list does not exist, removing from state", map[string]interface{}{
+				"project": projectID, "iid": iid,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read feature flag user list: %s", err.Error()))
+		return
+	}
+
+	data.userListToStateModel(projectID, &userList)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabProjectFeatureFlagUserListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectFeatureFlagUserListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, iid, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<iid>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var userList featureFlagUserList
+	if err := r.send(ctx, http.MethodPut, fmt.Sprintf("projects/%s/feature_flags_user_lists/%s", gitlab.PathEscape(projectID), iid), data.userListOptions(), &userList); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update feature flag user list: %s", err.Error()))
+		return
+	}
+
+	data.userListToStateModel(projectID, &userList)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabProjectFeatureFlagUserListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectFeatureFlagUserListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, iid, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<iid>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if err := r.send(ctx, http.MethodDelete, fmt.Sprintf("projects/%s/feature_flags_user_lists/%s", gitlab.PathEscape(projectID), iid), nil, nil); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete feature flag user list: %s", err.Error()))
+	}
+}
+
+func (r *gitlabProjectFeatureFlagUserListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// send executes a request against the feature flag user lists API, which is not yet available in go-gitlab.
+func (r *gitlabProjectFeatureFlagUserListResource) send(ctx context.Context, method string, path string, options interface{}, v interface{}) error {
+	req, err := r.client.NewRequest(method, path, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Do(req, v)
+	return err
+}
+
+func (data *gitlabProjectFeatureFlagUserListResourceModel) userListOptions() *featureFlagUserListOptions {
+	xids := make([]string, 0, len(data.UserXids))
+	for _, xid := range data.UserXids {
+		xids = append(xids, xid.ValueString())
+	}
+	return &featureFlagUserListOptions{
+		Name:     data.Name.ValueString(),
+		UserXids: strings.Join(xids, ","),
+	}
+}
+
+func (data *gitlabProjectFeatureFlagUserListResourceModel) userListToStateModel(projectID string, userList *featureFlagUserList) {
+	data.Project = types.StringValue(projectID)
+	data.Name = types.StringValue(userList.Name)
+	data.Iid = types.Int64Value(int64(userList.IID))
+	data.UserListId = types.Int64Value(int64(userList.ID))
+
+	data.UserXids = nil
+	for _, xid := range strings.Split(userList.UserXids, ",") {
+		if xid != "" {
+			data.UserXids = append(data.UserXids, types.StringValue(xid))
+		}
+	}
+}
+
+// The user IDs are sent as a comma separated list, so they must not contain any separator themselves.
+var userListXidRegex = regexp.MustCompile(`^[^,\s]+$`)
+
+type featureFlagUserList struct {
+	ID       int    `json:"id"`
+	IID      int    `json:"iid"`
+	Name     string `json:"name"`
+	UserXids string `json:"user_xids"`
+}
+
+type featureFlagUserListOptions struct {
+	Name     string `json:"name"`
+	UserXids string `json:"user_xids"`
+}
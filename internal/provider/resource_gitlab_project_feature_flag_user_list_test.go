@@ -0,0 +1,93 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabProjectFeatureFlagUserList_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectFeatureFlagUserList_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = "%d"
+						name      = "beta-testers"
+						user_xids = ["alice", "bob"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_feature_flag_user_list.this", "iid"),
+					resource.TestCheckResourceAttrSet("gitlab_project_feature_flag_user_list.this", "user_list_id"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "user_xids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_feature_flag_user_list.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = "%d"
+						name      = "early-adopters"
+						user_xids = ["alice", "bob", "carol"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "name", "early-adopters"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "user_xids.#", "3"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "user_xids.2", "carol"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_feature_flag_user_list.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectFeatureFlagUserList_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_feature_flag_user_list" {
+			continue
+		}
+
+		project, iid, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		req, err := testutil.TestGitlabClient.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/feature_flags_user_lists/%s", gitlab.PathEscape(project), iid), nil, nil)
+		if err != nil {
+			return err
+		}
+		_, err = testutil.TestGitlabClient.Do(req, nil)
+		if err == nil {
+			return fmt.Errorf("feature flag user list %s in project %s still exists", iid, project)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &gitlabProjectPackageProtectionRuleResource{}
+	_ resource.ResourceWithConfigure      = &gitlabProjectPackageProtectionRuleResource{}
+	_ resource.ResourceWithImportState    = &gitlabProjectPackageProtectionRuleResource{}
+	_ resource.ResourceWithValidateConfig = &gitlabProjectPackageProtectionRuleResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectPackageProtectionRuleResource)
+}
+
+func NewGitLabProjectPackageProtectionRuleResource() resource.Resource {
+	return &gitlabProjectPackageProtectionRuleResource{}
+}
+
+var validPackageProtectionRulePackageTypes = []string{"npm", "pypi", "maven", "conan", "helm", "nuget", "generic"}
+
+type gitlabProjectPackageProtectionRuleResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectPackageProtectionRuleResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Project                     types.String `tfsdk:"project"`
+	ProtectionRuleId            types.Int64  `tfsdk:"protection_rule_id"`
+	PackageNamePattern          types.String `tfsdk:"package_name_pattern"`
+	PackageType                 types.String `tfsdk:"package_type"`
+	MinimumAccessLevelForPush   types.String `tfsdk:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete types.String `tfsdk:"minimum_access_level_for_delete"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectPackageProtectionRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_package_protection_rule"
+}
+
+func (r *gitlabProjectPackageProtectionRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_package_protection_rule`" + ` resource allows to manage the lifecycle of a package protection rule of a project.
+
+A protection rule restricts which users can push or delete packages of the given type with a name matching the ` + "`package_name_pattern`" + `.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_packages_protection_rules.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<protection_rule_id>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"protection_rule_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the protection rule.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"package_name_pattern": schema.StringAttribute{
+				MarkdownDescription: "The name of the packages to protect. Supports the `*` wildcard, e.g. `@my-scope/my-package-*`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the packages to protect. Valid values are: %s.", utils.RenderValueListForDocs(validPackageProtectionRulePackageTypes)),
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(validPackageProtectionRulePackageTypes...)},
+			},
+			"minimum_access_level_for_push": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The minimum access level required to push packages. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidRegistryProtectionRuleAccessLevelNames)),
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidRegistryProtectionRuleAccessLevelNames...)},
+			},
+			"minimum_access_level_for_delete": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The minimum access level required to delete packages. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidRegistryProtectionRuleAccessLevelNames)),
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(api.ValidRegistryProtectionRuleAccessLevelNames...)},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that at least one of the minimum access levels is configured.
+func (r *gitlabProjectPackageProtectionRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *gitlabProjectPackageProtectionRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MinimumAccessLevelForPush.IsNull() && data.MinimumAccessLevelForDelete.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minimum_access_level_for_push"),
+			"Missing minimum access level",
+			"At least one of `minimum_access_level_for_push` and `minimum_access_level_for_delete` must be configured.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectPackageProtectionRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create creates a new upstream resource and adds it into the Terraform state.
+func (r *gitlabProjectPackageProtectionRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectPackageProtectionRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	options := &packageProtectionRuleOptions{
+		PackageNamePattern:          data.PackageNamePattern.ValueString(),
+		PackageType:                 data.PackageType.ValueString(),
+		MinimumAccessLevelForPush:   data.MinimumAccessLevelForPush.ValueStringPointer(),
+		MinimumAccessLevelForDelete: data.MinimumAccessLevelForDelete.ValueStringPointer(),
+	}
+
+	var rule packageProtectionRule
+	err := func() error {
+		req, err := r.client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/packages/protection/rules", gitlab.PathEscape(projectID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, &rule)
+		return err
+	}()
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create package protection rule: %s", err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	ruleID := strconv.Itoa(rule.ID)
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &ruleID))
+	data.packageProtectionRuleToStateModel(projectID, &rule)
+
+	tflog.Debug(ctx, "created a package protection rule", map[string]interface{}{
+		"project": projectID, "protection_rule_id": rule.ID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectPackageProtectionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectPackageProtectionRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// there is no API to get a single protection rule, therefore we have to search all rules of the project.
+	var rule *packageProtectionRule
+	options := &gitlab.ListOptions{PerPage: 100, Page: 1}
+	for options.Page != 0 && rule == nil {
+		var rules []*packageProtectionRule
+		req, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/packages/protection/rules", gitlab.PathEscape(projectID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read package protection rules: %s", err.Error()))
+			return
+		}
+		apiResp, err := r.client.Do(req, &rules)
+		if err != nil {
+			if api.Is404(err) {
+				tflog.Debug(ctx, "project does not exist, removing package protection rule from state", map[string]interface{}{
+					"project": projectID, "protection_rule_id": ruleID,
+				})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read package protection rules: %s", err.Error()))
+			return
+		}
+
+		for _, r := range rules {
+			if r.ID == ruleID {
+				rule = r
+				break
+			}
+		}
+		options.Page = apiResp.NextPage
+	}
+
+	if rule == nil {
+		tflog.Debug(ctx, "package protection rule does not exist, removing from state", map[string]interface{}{
+			"project": projectID, "protection_rule_id": ruleID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.packageProtectionRuleToStateModel(projectID, rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabProjectPackageProtectionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectPackageProtectionRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// unset access levels are sent as `null` to remove them from the rule
+	options := &packageProtectionRuleOptions{
+		PackageNamePattern:          data.PackageNamePattern.ValueString(),
+		PackageType:                 data.PackageType.ValueString(),
+		MinimumAccessLevelForPush:   data.MinimumAccessLevelForPush.ValueStringPointer(),
+		MinimumAccessLevelForDelete: data.MinimumAccessLevelForDelete.ValueStringPointer(),
+	}
+
+	var rule packageProtectionRule
+	err = func() error {
+		req, err := r.client.NewRequest(http.MethodPatch, fmt.Sprintf("projects/%s/packages/protection/rules/%d", gitlab.PathEscape(projectID), ruleID), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, &rule)
+		return err
+	}()
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update package protection rule: %s", err.Error()))
+		return
+	}
+
+	data.packageProtectionRuleToStateModel(projectID, &rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabProjectPackageProtectionRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectPackageProtectionRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, ruleID, err := r.parseID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<protection_rule_id>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = func() error {
+		req, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("projects/%s/packages/protection/rules/%d", gitlab.PathEscape(projectID), ruleID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.Do(req, nil)
+		return err
+	}()
+	if err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete package protection rule: %s", err.Error()))
+	}
+}
+
+func (r *gitlabProjectPackageProtectionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *gitlabProjectPackageProtectionRuleResource) parseID(id string) (string, int, error) {
+	projectID, rawRuleID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+	ruleID, err := strconv.Atoi(rawRuleID)
+	if err != nil {
+		return "", 0, err
+	}
+	return projectID, ruleID, nil
+}
+
+func (data *gitlabProjectPackageProtectionRuleResourceModel) packageProtectionRuleToStateModel(projectID string, rule *packageProtectionRule) {
+	data.Project = types.StringValue(projectID)
+	data.ProtectionRuleId = types.Int64Value(int64(rule.ID))
+	data.PackageNamePattern = types.StringValue(rule.PackageNamePattern)
+	data.PackageType = types.StringValue(rule.PackageType)
+	data.MinimumAccessLevelForPush = types.StringPointerValue(rule.MinimumAccessLevelForPush)
+	data.MinimumAccessLevelForDelete = types.StringPointerValue(rule.MinimumAccessLevelForDelete)
+}
+
+// packageProtectionRule is the API representation of a package protection rule.
+// The endpoints are not yet available in go-gitlab.
+type packageProtectionRule struct {
+	ID                          int     `json:"id"`
+	ProjectID                   int     `json:"project_id"`
+	PackageNamePattern          string  `json:"package_name_pattern"`
+	PackageType                 string  `json:"package_type"`
+	MinimumAccessLevelForPush   *string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete *string `json:"minimum_access_level_for_delete"`
+}
+
+type packageProtectionRuleOptions struct {
+	PackageNamePattern          string  `json:"package_name_pattern"`
+	PackageType                 string  `json:"package_type"`
+	MinimumAccessLevelForPush   *string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete *string `json:"minimum_access_level_for_delete"`
+}
@@ -0,0 +1,107 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabProjectPackageProtectionRule_basic(t *testing.T) {
+	testutil.RunIfAtLeast(t, "16.5")
+
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectPackageProtectionRule_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create a protection rule with only the push access level
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_package_protection_rule" "this" {
+						project                       = "%[1]d"
+						package_name_pattern          = "@%[2]s/*"
+						package_type                  = "npm"
+						minimum_access_level_for_push = "maintainer"
+					}
+				`, testProject.ID, testProject.Path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_package_protection_rule.this", "protection_rule_id"),
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_push", "maintainer"),
+					resource.TestCheckNoResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_delete"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_package_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the pattern and access levels in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_package_protection_rule" "this" {
+						project                         = "%[1]d"
+						package_name_pattern            = "@%[2]s/release-*"
+						package_type                    = "npm"
+						minimum_access_level_for_push   = "owner"
+						minimum_access_level_for_delete = "admin"
+					}
+				`, testProject.ID, testProject.Path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "package_name_pattern", fmt.Sprintf("@%s/release-*", testProject.Path)),
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_push", "owner"),
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_delete", "admin"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_package_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectPackageProtectionRule_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_package_protection_rule" {
+			continue
+		}
+
+		project, rawRuleID, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ruleID, err := strconv.Atoi(rawRuleID)
+		if err != nil {
+			return err
+		}
+
+		req, err := testutil.TestGitlabClient.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/packages/protection/rules", gitlab.PathEscape(project)), &gitlab.ListOptions{PerPage: 100}, nil)
+		if err != nil {
+			return err
+		}
+		var rules []*packageProtectionRule
+		if _, err := testutil.TestGitlabClient.Do(req, &rules); err != nil {
+			// the project has been deleted, which also deletes the rules
+			continue
+		}
+		for _, rule := range rules {
+			if rule.ID == ruleID {
+				return fmt.Errorf("package protection rule %d in project %s still exists", ruleID, project)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabProjectPackagesCleanupPolicyResource{}
+	_ resource.ResourceWithConfigure   = &gitlabProjectPackagesCleanupPolicyResource{}
+	_ resource.ResourceWithImportState = &gitlabProjectPackagesCleanupPolicyResource{}
+)
+
+func init() {
+	registerResource(NewGitLabProjectPackagesCleanupPolicyResource)
+}
+
+func NewGitLabProjectPackagesCleanupPolicyResource() resource.Resource {
+	return &gitlabProjectPackagesCleanupPolicyResource{}
+}
+
+// The values of `keep_n_duplicated_package_files` mapped to the GraphQL enum values.
+var packagesCleanupKeepDuplicatedPackageFilesValues = map[string]string{
+	"all": "ALL",
+	"1":   "ONE_PACKAGE_FILE",
+	"10":  "TEN_PACKAGE_FILES",
+	"20":  "TWENTY_PACKAGE_FILES",
+	"30":  "THIRTY_PACKAGE_FILES",
+	"40":  "FORTY_PACKAGE_FILES",
+	"50":  "FIFTY_PACKAGE_FILES",
+}
+
+var validPackagesCleanupKeepDuplicatedPackageFilesValues = []string{"all", "1", "10", "20", "30", "40", "50"}
+
+type gitlabProjectPackagesCleanupPolicyResource struct {
+	client *gitlab.Client
+}
+
+type gitlabProjectPackagesCleanupPolicyResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Project                     types.String `tfsdk:"project"`
+	KeepNDuplicatedPackageFiles types.String `tfsdk:"keep_n_duplicated_package_files"`
+	NextRunAt                   types.String `tfsdk:"next_run_at"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabProjectPackagesCleanupPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_packages_cleanup_policy"
+}
+
+func (r *gitlabProjectPackagesCleanupPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_packages_cleanup_policy`" + ` resource allows to manage the cleanup policy for packages of an existing project.
+
+-> Destroying this resource resets the policy to keep all duplicated package files, which is the GitLab default.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationupdatepackagescleanuppolicy)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"keep_n_duplicated_package_files": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The number of duplicated package files to keep. Valid values are: %s.", utils.RenderValueListForDocs(validPackagesCleanupKeepDuplicatedPackageFilesValues)),
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(validPackagesCleanupKeepDuplicatedPackageFilesValues...)},
+			},
+			"next_run_at": schema.StringAttribute{
+				MarkdownDescription: "The next time the policy will run.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectPackagesCleanupPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create applies the packages cleanup policy to the project and adds it into the Terraform state.
+func (r *gitlabProjectPackagesCleanupPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectPackagesCleanupPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	policy, err := r.update(ctx, projectID, data.KeepNDuplicatedPackageFiles.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update packages cleanup policy of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.Id = types.StringValue(projectID)
+	data.packagesCleanupPolicyToStateModel(policy)
+
+	tflog.Debug(ctx, "updated packages cleanup policy of project", map[string]interface{}{
+		"project": projectID,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectPackagesCleanupPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectPackagesCleanupPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "project does not exist, removing packages cleanup policy from state", map[string]interface{}{
+				"project": projectID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			query {
+				project(fullPath: "%s") {
+					packagesCleanupPolicy {
+						keepNDuplicatedPackageFiles,
+						nextRunAt
+					}
+				}
+			}`, project.PathWithNamespace),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to retrieve packages cleanup policy", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response packagesCleanupPolicyResponse
+	if _, err := api.SendGraphQLRequest(ctx, r.client, query, &response); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read packages cleanup policy of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.Project = types.StringValue(projectID)
+	data.packagesCleanupPolicyToStateModel(response.Data.Project.PackagesCleanupPolicy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the packages cleanup policy in-place.
+func (r *gitlabProjectPackagesCleanupPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectPackagesCleanupPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	policy, err := r.update(ctx, projectID, data.KeepNDuplicatedPackageFiles.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update packages cleanup policy of project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	data.packagesCleanupPolicyToStateModel(policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete resets the packages cleanup policy to the GitLab default.
+func (r *gitlabProjectPackagesCleanupPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectPackagesCleanupPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	if _, err := r.update(ctx, projectID, "all"); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to reset packages cleanup policy of project %q: %s", projectID, err.Error()))
+	}
+}
+
+func (r *gitlabProjectPackagesCleanupPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// update sets the number of duplicated package files to keep and returns the updated policy.
+func (r *gitlabProjectPackagesCleanupPolicyResource) update(ctx context.Context, projectID string, keepNDuplicatedPackageFiles string) (*graphQLPackagesCleanupPolicy, error) {
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			mutation {
+				updatePackagesCleanupPolicy(
+					input: {
+						projectPath: "%s",
+						keepNDuplicatedPackageFiles: %s
+					}
+				) {
+					packagesCleanupPolicy {
+						keepNDuplicatedPackageFiles,
+						nextRunAt
+					}
+					errors
+				}
+			}`, project.PathWithNamespace, packagesCleanupKeepDuplicatedPackageFilesValues[keepNDuplicatedPackageFiles]),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to update packages cleanup policy", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response packagesCleanupPolicyMutationResponse
+	if _, err := api.SendGraphQLRequest(ctx, r.client, query, &response); err != nil {
+		return nil, err
+	}
+	if errs := response.Data.UpdatePackagesCleanupPolicy.Errors; len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return response.Data.UpdatePackagesCleanupPolicy.PackagesCleanupPolicy, nil
+}
+
+func (data *gitlabProjectPackagesCleanupPolicyResourceModel) packagesCleanupPolicyToStateModel(policy *graphQLPackagesCleanupPolicy) {
+	// Projects without an explicit policy keep all duplicated package files.
+	data.KeepNDuplicatedPackageFiles = types.StringValue("all")
+	data.NextRunAt = types.StringNull()
+	if policy == nil {
+		return
+	}
+
+	for value, enum := range packagesCleanupKeepDuplicatedPackageFilesValues {
+		if enum == policy.KeepNDuplicatedPackageFiles {
+			data.KeepNDuplicatedPackageFiles = types.StringValue(value)
+		}
+	}
+	data.NextRunAt = types.StringPointerValue(policy.NextRunAt)
+}
+
+type packagesCleanupPolicyResponse struct {
+	Data struct {
+		Project struct {
+			PackagesCleanupPolicy *graphQLPackagesCleanupPolicy `json:"packagesCleanupPolicy"`
+		} `json:"project"`
+	} `json:"data"`
+}
+
+type packagesCleanupPolicyMutationResponse struct {
+	Data struct {
+		UpdatePackagesCleanupPolicy struct {
+			PackagesCleanupPolicy *graphQLPackagesCleanupPolicy `json:"packagesCleanupPolicy"`
+			Errors                []string                      `json:"errors"`
+		} `json:"updatePackagesCleanupPolicy"`
+	} `json:"data"`
+}
+
+type graphQLPackagesCleanupPolicy struct {
+	KeepNDuplicatedPackageFiles string  `json:"keepNDuplicatedPackageFiles"`
+	NextRunAt                   *string `json:"nextRunAt"`
+}
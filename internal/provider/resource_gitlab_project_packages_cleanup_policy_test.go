@@ -0,0 +1,83 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectPackagesCleanupPolicy_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectPackagesCleanupPolicy_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_packages_cleanup_policy" "this" {
+						project                         = "%d"
+						keep_n_duplicated_package_files = "10"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_packages_cleanup_policy.this", "keep_n_duplicated_package_files", "10"),
+					resource.TestCheckResourceAttrSet("gitlab_project_packages_cleanup_policy.this", "next_run_at"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_packages_cleanup_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_packages_cleanup_policy" "this" {
+						project                         = "%d"
+						keep_n_duplicated_package_files = "1"
+					}
+				`, testProject.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_project_packages_cleanup_policy.this", "keep_n_duplicated_package_files", "1"),
+			},
+			{
+				ResourceName:      "gitlab_project_packages_cleanup_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectPackagesCleanupPolicy_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_packages_cleanup_policy" {
+			continue
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		query := api.GraphQLQuery{
+			Query: fmt.Sprintf(`query { project(fullPath: "%s") { packagesCleanupPolicy { keepNDuplicatedPackageFiles } } }`, project.PathWithNamespace),
+		}
+		var response packagesCleanupPolicyResponse
+		if _, err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, query, &response); err != nil {
+			return err
+		}
+		if policy := response.Data.Project.PackagesCleanupPolicy; policy != nil && policy.KeepNDuplicatedPackageFiles != "ALL" {
+			return fmt.Errorf("packages cleanup policy of project %s has not been reset", rs.Primary.ID)
+		}
+	}
+	return nil
+}
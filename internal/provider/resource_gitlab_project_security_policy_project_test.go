@@ -0,0 +1,86 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectSecurityPolicyProject_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProject := testutil.CreateProject(t)
+	firstPolicyProject := testutil.CreateProject(t)
+	secondPolicyProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectSecurityPolicyProject_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Link a security policy project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_security_policy_project" "foo" {
+						project        = "%d"
+						policy_project = "%s"
+					}
+				`, testProject.ID, firstPolicyProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("gitlab_project_security_policy_project.foo", "policy_project", firstPolicyProject.PathWithNamespace),
+			},
+			{
+				ResourceName:      "gitlab_project_security_policy_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Link a different security policy project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_security_policy_project" "foo" {
+						project        = "%d"
+						policy_project = "%s"
+					}
+				`, testProject.ID, secondPolicyProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("gitlab_project_security_policy_project.foo", "policy_project", secondPolicyProject.PathWithNamespace),
+			},
+			{
+				ResourceName:      "gitlab_project_security_policy_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectSecurityPolicyProject_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_security_policy_project" {
+			continue
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+
+		policyProject, err := readSecurityPolicyProject(context.Background(), testutil.TestGitlabClient, "project", project.PathWithNamespace)
+		if err != nil {
+			return err
+		}
+		if policyProject != nil {
+			return fmt.Errorf("Security policy project %s is still linked to project %s", policyProject.FullPath, project.PathWithNamespace)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,830 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &gitlabSecurityPolicyResource{}
+	_ resource.ResourceWithConfigure   = &gitlabSecurityPolicyResource{}
+	_ resource.ResourceWithImportState = &gitlabSecurityPolicyResource{}
+)
+
+// securityPolicyFilePath is the location in the security policy project from which GitLab reads the policies.
+const securityPolicyFilePath = ".gitlab/security-policies/policy.yml"
+
+var (
+	validScanExecutionPolicyRuleTypes   = []string{"pipeline", "schedule"}
+	validScanExecutionPolicyActionScans = []string{"dast", "secret_detection", "sast", "sast_iac", "container_scanning", "dependency_scanning"}
+	validScanResultPolicyRuleTypes      = []string{"scan_finding", "license_finding", "any_merge_request"}
+	validScanResultPolicyActionTypes    = []string{"require_approval"}
+	validSecurityPolicyBranchTypes      = []string{"all", "protected", "default"}
+)
+
+func init() {
+	registerResource(NewGitLabSecurityPolicyResource)
+}
+
+func NewGitLabSecurityPolicyResource() resource.Resource {
+	return &gitlabSecurityPolicyResource{}
+}
+
+type gitlabSecurityPolicyResource struct {
+	client *gitlab.Client
+}
+
+type gitlabSecurityPolicyResourceModel struct {
+	Id                  types.String                     `tfsdk:"id"`
+	Project             types.String                     `tfsdk:"project"`
+	Branch              types.String                     `tfsdk:"branch"`
+	CommitMessage       types.String                     `tfsdk:"commit_message"`
+	AuthorEmail         types.String                     `tfsdk:"author_email"`
+	AuthorName          types.String                     `tfsdk:"author_name"`
+	Content             types.String                     `tfsdk:"content"`
+	ScanExecutionPolicy []gitlabScanExecutionPolicyModel `tfsdk:"scan_execution_policy"`
+	ScanResultPolicy    []gitlabScanResultPolicyModel    `tfsdk:"scan_result_policy"`
+}
+
+type gitlabScanExecutionPolicyModel struct {
+	Name        types.String                           `tfsdk:"name"`
+	Description types.String                           `tfsdk:"description"`
+	Enabled     types.Bool                             `tfsdk:"enabled"`
+	Rules       []gitlabScanExecutionPolicyRuleModel   `tfsdk:"rules"`
+	Actions     []gitlabScanExecutionPolicyActionModel `tfsdk:"actions"`
+}
+
+type gitlabScanExecutionPolicyRuleModel struct {
+	Type             types.String   `tfsdk:"type"`
+	Branches         []types.String `tfsdk:"branches"`
+	BranchType       types.String   `tfsdk:"branch_type"`
+	BranchExceptions []types.String `tfsdk:"branch_exceptions"`
+	Cadence          types.String   `tfsdk:"cadence"`
+	Timezone         types.String   `tfsdk:"timezone"`
+}
+
+type gitlabScanExecutionPolicyActionModel struct {
+	Scan           types.String            `tfsdk:"scan"`
+	SiteProfile    types.String            `tfsdk:"site_profile"`
+	ScannerProfile types.String            `tfsdk:"scanner_profile"`
+	Tags           []types.String          `tfsdk:"tags"`
+	Variables      map[string]types.String `tfsdk:"variables"`
+}
+
+type gitlabScanResultPolicyModel struct {
+	Name        types.String                        `tfsdk:"name"`
+	Description types.String                        `tfsdk:"description"`
+	Enabled     types.Bool                          `tfsdk:"enabled"`
+	Rules       []gitlabScanResultPolicyRuleModel   `tfsdk:"rules"`
+	Actions     []gitlabScanResultPolicyActionModel `tfsdk:"actions"`
+}
+
+type gitlabScanResultPolicyRuleModel struct {
+	Type                   types.String   `tfsdk:"type"`
+	Branches               []types.String `tfsdk:"branches"`
+	BranchType             types.String   `tfsdk:"branch_type"`
+	BranchExceptions       []types.String `tfsdk:"branch_exceptions"`
+	Scanners               []types.String `tfsdk:"scanners"`
+	VulnerabilitiesAllowed types.Int64    `tfsdk:"vulnerabilities_allowed"`
+	SeverityLevels         []types.String `tfsdk:"severity_levels"`
+	VulnerabilityStates    []types.String `tfsdk:"vulnerability_states"`
+	MatchOnInclusion       types.Bool     `tfsdk:"match_on_inclusion"`
+	LicenseTypes           []types.String `tfsdk:"license_types"`
+	LicenseStates          []types.String `tfsdk:"license_states"`
+}
+
+type gitlabScanResultPolicyActionModel struct {
+	Type              types.String   `tfsdk:"type"`
+	ApprovalsRequired types.Int64    `tfsdk:"approvals_required"`
+	UserApprovers     []types.String `tfsdk:"user_approvers"`
+	UserApproversIds  []types.Int64  `tfsdk:"user_approvers_ids"`
+	GroupApprovers    []types.String `tfsdk:"group_approvers"`
+	GroupApproversIds []types.Int64  `tfsdk:"group_approvers_ids"`
+	RoleApprovers     []types.String `tfsdk:"role_approvers"`
+}
+
+// Metadata returns the resource name
+func (r *gitlabSecurityPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_policy"
+}
+
+func (r *gitlabSecurityPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_security_policy`" + ` resource allows to manage the scan execution and scan result policies of a security policy project.
+
+The policies are rendered as YAML and committed to ` + "`" + securityPolicyFilePath + "`" + ` in the security policy project.
+Use the ` + "`gitlab_project_security_policy_project`" + ` or ` + "`gitlab_group_security_policy_project`" + ` resources to link the security policy project to projects and groups.
+
+-> This resource manages the whole policy file. Policies which are not configured in this resource are removed from the file on the next apply.
+
+-> This resource requires a GitLab Enterprise instance with an Ultimate license.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<project>:<branch>`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the security policy project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch to commit the policy file to. Defaults to the default branch of the project. GitLab only enforces policies from the default branch.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Commit message used when the policy file is changed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Update security policies"),
+			},
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the commit author.",
+				Optional:            true,
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the commit author.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The rendered content of the policy file.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"scan_execution_policy": schema.ListNestedBlock{
+				MarkdownDescription: "Scan execution policies which enforce security scans in pipelines or on a schedule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: securityPolicyCommonAttributes(),
+					Blocks: map[string]schema.Block{
+						"rules": schema.ListNestedBlock{
+							MarkdownDescription: "The rules which trigger the scans.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: fmt.Sprintf("The rule type. Valid values are: %s.", utils.RenderValueListForDocs(validScanExecutionPolicyRuleTypes)),
+										Required:            true,
+										Validators:          []validator.String{stringvalidator.OneOf(validScanExecutionPolicyRuleTypes...)},
+									},
+									"branches":          securityPolicyBranchesAttribute(),
+									"branch_type":       securityPolicyBranchTypeAttribute(),
+									"branch_exceptions": securityPolicyBranchExceptionsAttribute(),
+									"cadence": schema.StringAttribute{
+										MarkdownDescription: "The cron expression of the schedule. Only for rules of type `schedule`.",
+										Optional:            true,
+									},
+									"timezone": schema.StringAttribute{
+										MarkdownDescription: "The time zone of the `cadence`, e.g. `Europe/Berlin`. Only for rules of type `schedule`.",
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"actions": schema.ListNestedBlock{
+							MarkdownDescription: "The scans which are enforced.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"scan": schema.StringAttribute{
+										MarkdownDescription: fmt.Sprintf("The scan to run. Valid values are: %s.", utils.RenderValueListForDocs(validScanExecutionPolicyActionScans)),
+										Required:            true,
+										Validators:          []validator.String{stringvalidator.OneOf(validScanExecutionPolicyActionScans...)},
+									},
+									"site_profile": schema.StringAttribute{
+										MarkdownDescription: "The name of the DAST site profile. Only for `dast` scans.",
+										Optional:            true,
+									},
+									"scanner_profile": schema.StringAttribute{
+										MarkdownDescription: "The name of the DAST scanner profile. Only for `dast` scans.",
+										Optional:            true,
+									},
+									"tags": schema.ListAttribute{
+										MarkdownDescription: "The runner tags used for the scan jobs.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"variables": schema.MapAttribute{
+										MarkdownDescription: "CI/CD variables passed to the scan jobs.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scan_result_policy": schema.ListNestedBlock{
+				MarkdownDescription: "Scan result policies which require approvals on merge requests based on scan results.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: securityPolicyCommonAttributes(),
+					Blocks: map[string]schema.Block{
+						"rules": schema.ListNestedBlock{
+							MarkdownDescription: "The rules which require the approvals.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: fmt.Sprintf("The rule type. Valid values are: %s.", utils.RenderValueListForDocs(validScanResultPolicyRuleTypes)),
+										Required:            true,
+										Validators:          []validator.String{stringvalidator.OneOf(validScanResultPolicyRuleTypes...)},
+									},
+									"branches":          securityPolicyBranchesAttribute(),
+									"branch_type":       securityPolicyBranchTypeAttribute(),
+									"branch_exceptions": securityPolicyBranchExceptionsAttribute(),
+									"scanners": schema.ListAttribute{
+										MarkdownDescription: "The scanners to consider, e.g. `container_scanning`. Only for rules of type `scan_finding`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"vulnerabilities_allowed": schema.Int64Attribute{
+										MarkdownDescription: "The number of vulnerabilities allowed before the rule is triggered. Only for rules of type `scan_finding`.",
+										Optional:            true,
+										Validators:          []validator.Int64{int64validator.AtLeast(0)},
+									},
+									"severity_levels": schema.ListAttribute{
+										MarkdownDescription: "The severity levels to consider, e.g. `critical`. Only for rules of type `scan_finding`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"vulnerability_states": schema.ListAttribute{
+										MarkdownDescription: "The vulnerability states to consider, e.g. `newly_detected`. Only for rules of type `scan_finding`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"match_on_inclusion": schema.BoolAttribute{
+										MarkdownDescription: "Whether the rule matches licenses included in `license_types` or excluded from them. Only for rules of type `license_finding`.",
+										Optional:            true,
+									},
+									"license_types": schema.ListAttribute{
+										MarkdownDescription: "The license types to match. Only for rules of type `license_finding`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"license_states": schema.ListAttribute{
+										MarkdownDescription: "The license states to consider, e.g. `newly_detected`. Only for rules of type `license_finding`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+						"actions": schema.ListNestedBlock{
+							MarkdownDescription: "The approvals which are required if a rule is triggered.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: fmt.Sprintf("The action type. Valid values are: %s.", utils.RenderValueListForDocs(validScanResultPolicyActionTypes)),
+										Required:            true,
+										Validators:          []validator.String{stringvalidator.OneOf(validScanResultPolicyActionTypes...)},
+									},
+									"approvals_required": schema.Int64Attribute{
+										MarkdownDescription: "The number of approvals required.",
+										Required:            true,
+										Validators:          []validator.Int64{int64validator.AtLeast(0)},
+									},
+									"user_approvers": schema.ListAttribute{
+										MarkdownDescription: "The usernames of the users allowed to approve.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"user_approvers_ids": schema.ListAttribute{
+										MarkdownDescription: "The IDs of the users allowed to approve.",
+										Optional:            true,
+										ElementType:         types.Int64Type,
+									},
+									"group_approvers": schema.ListAttribute{
+										MarkdownDescription: "The full paths of the groups whose members are allowed to approve.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"group_approvers_ids": schema.ListAttribute{
+										MarkdownDescription: "The IDs of the groups whose members are allowed to approve.",
+										Optional:            true,
+										ElementType:         types.Int64Type,
+									},
+									"role_approvers": schema.ListAttribute{
+										MarkdownDescription: "The roles allowed to approve, e.g. `maintainer`.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func securityPolicyCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the policy.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the policy.",
+			Optional:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy is enforced. Default: `true`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+func securityPolicyBranchesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "The branches the rule applies to. Supports wildcards, e.g. `release/*`. Conflicts with `branch_type`.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators:          []validator.List{listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("branch_type"))},
+	}
+}
+
+func securityPolicyBranchTypeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The type of branches the rule applies to. Valid values are: %s.", utils.RenderValueListForDocs(validSecurityPolicyBranchTypes)),
+		Optional:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(validSecurityPolicyBranchTypes...)},
+	}
+}
+
+func securityPolicyBranchExceptionsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "The branches excluded from the rule.",
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabSecurityPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*gitlab.Client)
+}
+
+// Create commits the policy file and adds it into the Terraform state.
+func (r *gitlabSecurityPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabSecurityPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Project.ValueString()
+	if data.Branch.IsNull() || data.Branch.IsUnknown() {
+		project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", projectID, err.Error()))
+			return
+		}
+		data.Branch = types.StringValue(project.DefaultBranch)
+	}
+	branch := data.Branch.ValueString()
+
+	if err := r.commit(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to commit security policy file to project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	data.Id = types.StringValue(utils.BuildTwoPartID(&projectID, &branch))
+
+	tflog.Debug(ctx, "committed security policy file", map[string]interface{}{
+		"project": projectID, "branch": branch,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabSecurityPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabSecurityPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, branch, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<branch>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	content, _, err := api.GetRepositoryFileContent(ctx, r.client, projectID, securityPolicyFilePath, branch)
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "security policy file does not exist, removing from state", map[string]interface{}{
+				"project": projectID, "branch": branch,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read security policy file: %s", err.Error()))
+		return
+	}
+
+	var policies securityPolicyYAML
+	if err := yaml.Unmarshal([]byte(content), &policies); err != nil {
+		resp.Diagnostics.AddError("Invalid security policy file", fmt.Sprintf("Unable to parse %s in project %q: %s", securityPolicyFilePath, projectID, err.Error()))
+		return
+	}
+
+	data.Project = types.StringValue(projectID)
+	data.Branch = types.StringValue(branch)
+	data.Content = types.StringValue(content)
+	data.securityPolicyFromYAML(&policies)
+	if data.CommitMessage.IsNull() {
+		// e.g. after an import
+		data.CommitMessage = types.StringValue("Update security policies")
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update commits the changed policy file.
+func (r *gitlabSecurityPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabSecurityPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.commit(ctx, data); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to commit security policy file to project %q: %s", data.Project.ValueString(), err.Error()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the policy file from the security policy project.
+func (r *gitlabSecurityPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabSecurityPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, branch, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<branch>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	options := &api.RepositoryFileCommitOptions{
+		Branch:        branch,
+		CommitMessage: fmt.Sprintf("[DELETE]: %s", data.CommitMessage.ValueString()),
+		AuthorEmail:   data.AuthorEmail.ValueString(),
+		AuthorName:    data.AuthorName.ValueString(),
+		Timeout:       1 * time.Minute,
+	}
+	if err := api.DeleteRepositoryFile(ctx, r.client, projectID, securityPolicyFilePath, options); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete security policy file: %s", err.Error()))
+	}
+}
+
+func (r *gitlabSecurityPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// commit renders the configured policies and commits them to the policy file.
+func (r *gitlabSecurityPolicyResource) commit(ctx context.Context, data *gitlabSecurityPolicyResourceModel) error {
+	content, err := yaml.Marshal(data.securityPolicyToYAML())
+	if err != nil {
+		return fmt.Errorf("unable to render security policy file: %w", err)
+	}
+
+	options := &api.RepositoryFileCommitOptions{
+		Branch:        data.Branch.ValueString(),
+		CommitMessage: data.CommitMessage.ValueString(),
+		AuthorEmail:   data.AuthorEmail.ValueString(),
+		AuthorName:    data.AuthorName.ValueString(),
+		Timeout:       1 * time.Minute,
+	}
+	if err := api.CommitRepositoryFile(ctx, r.client, data.Project.ValueString(), securityPolicyFilePath, string(content), options); err != nil {
+		return err
+	}
+
+	data.Content = types.StringValue(string(content))
+	return nil
+}
+
+func (data *gitlabSecurityPolicyResourceModel) securityPolicyToYAML() *securityPolicyYAML {
+	policies := &securityPolicyYAML{}
+
+	for _, p := range data.ScanExecutionPolicy {
+		policy := scanExecutionPolicyYAML{
+			Name:        p.Name.ValueString(),
+			Description: p.Description.ValueStringPointer(),
+			Enabled:     p.Enabled.ValueBool(),
+		}
+		for _, rule := range p.Rules {
+			policy.Rules = append(policy.Rules, scanExecutionPolicyRuleYAML{
+				Type:             rule.Type.ValueString(),
+				Branches:         securityPolicyStringsToYAML(rule.Branches),
+				BranchType:       rule.BranchType.ValueStringPointer(),
+				BranchExceptions: securityPolicyStringsToYAML(rule.BranchExceptions),
+				Cadence:          rule.Cadence.ValueStringPointer(),
+				Timezone:         rule.Timezone.ValueStringPointer(),
+			})
+		}
+		for _, action := range p.Actions {
+			actionYAML := scanExecutionPolicyActionYAML{
+				Scan:           action.Scan.ValueString(),
+				SiteProfile:    action.SiteProfile.ValueStringPointer(),
+				ScannerProfile: action.ScannerProfile.ValueStringPointer(),
+				Tags:           securityPolicyStringsToYAML(action.Tags),
+			}
+			if action.Variables != nil {
+				variables := make(map[string]string, len(action.Variables))
+				for k, v := range action.Variables {
+					variables[k] = v.ValueString()
+				}
+				actionYAML.Variables = &variables
+			}
+			policy.Actions = append(policy.Actions, actionYAML)
+		}
+		policies.ScanExecutionPolicy = append(policies.ScanExecutionPolicy, policy)
+	}
+
+	for _, p := range data.ScanResultPolicy {
+		policy := scanResultPolicyYAML{
+			Name:        p.Name.ValueString(),
+			Description: p.Description.ValueStringPointer(),
+			Enabled:     p.Enabled.ValueBool(),
+		}
+		for _, rule := range p.Rules {
+			policy.Rules = append(policy.Rules, scanResultPolicyRuleYAML{
+				Type:                   rule.Type.ValueString(),
+				Branches:               securityPolicyStringsToYAML(rule.Branches),
+				BranchType:             rule.BranchType.ValueStringPointer(),
+				BranchExceptions:       securityPolicyStringsToYAML(rule.BranchExceptions),
+				Scanners:               securityPolicyStringsToYAML(rule.Scanners),
+				VulnerabilitiesAllowed: rule.VulnerabilitiesAllowed.ValueInt64Pointer(),
+				SeverityLevels:         securityPolicyStringsToYAML(rule.SeverityLevels),
+				VulnerabilityStates:    securityPolicyStringsToYAML(rule.VulnerabilityStates),
+				MatchOnInclusion:       rule.MatchOnInclusion.ValueBoolPointer(),
+				LicenseTypes:           securityPolicyStringsToYAML(rule.LicenseTypes),
+				LicenseStates:          securityPolicyStringsToYAML(rule.LicenseStates),
+			})
+		}
+		for _, action := range p.Actions {
+			policy.Actions = append(policy.Actions, scanResultPolicyActionYAML{
+				Type:              action.Type.ValueString(),
+				ApprovalsRequired: action.ApprovalsRequired.ValueInt64(),
+				UserApprovers:     securityPolicyStringsToYAML(action.UserApprovers),
+				UserApproversIds:  securityPolicyInt64sToYAML(action.UserApproversIds),
+				GroupApprovers:    securityPolicyStringsToYAML(action.GroupApprovers),
+				GroupApproversIds: securityPolicyInt64sToYAML(action.GroupApproversIds),
+				RoleApprovers:     securityPolicyStringsToYAML(action.RoleApprovers),
+			})
+		}
+		policies.ScanResultPolicy = append(policies.ScanResultPolicy, policy)
+	}
+
+	return policies
+}
+
+func (data *gitlabSecurityPolicyResourceModel) securityPolicyFromYAML(policies *securityPolicyYAML) {
+	data.ScanExecutionPolicy = nil
+	for _, p := range policies.ScanExecutionPolicy {
+		policy := gitlabScanExecutionPolicyModel{
+			Name:        types.StringValue(p.Name),
+			Description: types.StringPointerValue(p.Description),
+			Enabled:     types.BoolValue(p.Enabled),
+		}
+		for _, rule := range p.Rules {
+			policy.Rules = append(policy.Rules, gitlabScanExecutionPolicyRuleModel{
+				Type:             types.StringValue(rule.Type),
+				Branches:         securityPolicyStringsFromYAML(rule.Branches),
+				BranchType:       types.StringPointerValue(rule.BranchType),
+				BranchExceptions: securityPolicyStringsFromYAML(rule.BranchExceptions),
+				Cadence:          types.StringPointerValue(rule.Cadence),
+				Timezone:         types.StringPointerValue(rule.Timezone),
+			})
+		}
+		for _, action := range p.Actions {
+			actionModel := gitlabScanExecutionPolicyActionModel{
+				Scan:           types.StringValue(action.Scan),
+				SiteProfile:    types.StringPointerValue(action.SiteProfile),
+				ScannerProfile: types.StringPointerValue(action.ScannerProfile),
+				Tags:           securityPolicyStringsFromYAML(action.Tags),
+			}
+			if action.Variables != nil {
+				actionModel.Variables = make(map[string]types.String, len(*action.Variables))
+				for k, v := range *action.Variables {
+					actionModel.Variables[k] = types.StringValue(v)
+				}
+			}
+			policy.Actions = append(policy.Actions, actionModel)
+		}
+		data.ScanExecutionPolicy = append(data.ScanExecutionPolicy, policy)
+	}
+
+	data.ScanResultPolicy = nil
+	for _, p := range policies.ScanResultPolicy {
+		policy := gitlabScanResultPolicyModel{
+			Name:        types.StringValue(p.Name),
+			Description: types.StringPointerValue(p.Description),
+			Enabled:     types.BoolValue(p.Enabled),
+		}
+		for _, rule := range p.Rules {
+			policy.Rules = append(policy.Rules, gitlabScanResultPolicyRuleModel{
+				Type:                   types.StringValue(rule.Type),
+				Branches:               securityPolicyStringsFromYAML(rule.Branches),
+				BranchType:             types.StringPointerValue(rule.BranchType),
+				BranchExceptions:       securityPolicyStringsFromYAML(rule.BranchExceptions),
+				Scanners:               securityPolicyStringsFromYAML(rule.Scanners),
+				VulnerabilitiesAllowed: types.Int64PointerValue(rule.VulnerabilitiesAllowed),
+				SeverityLevels:         securityPolicyStringsFromYAML(rule.SeverityLevels),
+				VulnerabilityStates:    securityPolicyStringsFromYAML(rule.VulnerabilityStates),
+				MatchOnInclusion:       types.BoolPointerValue(rule.MatchOnInclusion),
+				LicenseTypes:           securityPolicyStringsFromYAML(rule.LicenseTypes),
+				LicenseStates:          securityPolicyStringsFromYAML(rule.LicenseStates),
+			})
+		}
+		for _, action := range p.Actions {
+			policy.Actions = append(policy.Actions, gitlabScanResultPolicyActionModel{
+				Type:              types.StringValue(action.Type),
+				ApprovalsRequired: types.Int64Value(action.ApprovalsRequired),
+				UserApprovers:     securityPolicyStringsFromYAML(action.UserApprovers),
+				UserApproversIds:  securityPolicyInt64sFromYAML(action.UserApproversIds),
+				GroupApprovers:    securityPolicyStringsFromYAML(action.GroupApprovers),
+				GroupApproversIds: securityPolicyInt64sFromYAML(action.GroupApproversIds),
+				RoleApprovers:     securityPolicyStringsFromYAML(action.RoleApprovers),
+			})
+		}
+		data.ScanResultPolicy = append(data.ScanResultPolicy, policy)
+	}
+}
+
+// securityPolicyStringsToYAML converts a list attribute to its YAML representation.
+// A null list is omitted from the YAML, whereas an empty list is rendered as `[]`.
+func securityPolicyStringsToYAML(values []types.String) *[]string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return &result
+}
+
+func securityPolicyStringsFromYAML(values *[]string) []types.String {
+	if values == nil {
+		return nil
+	}
+	result := make([]types.String, 0, len(*values))
+	for _, v := range *values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+func securityPolicyInt64sToYAML(values []types.Int64) *[]int64 {
+	if values == nil {
+		return nil
+	}
+	result := make([]int64, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueInt64())
+	}
+	return &result
+}
+
+func securityPolicyInt64sFromYAML(values *[]int64) []types.Int64 {
+	if values == nil {
+		return nil
+	}
+	result := make([]types.Int64, 0, len(*values))
+	for _, v := range *values {
+		result = append(result, types.Int64Value(v))
+	}
+	return result
+}
+
+// securityPolicyYAML is the structure of the policy file read by GitLab.
+// see https://docs.gitlab.com/ee/user/application_security/policies/#policy-project
+type securityPolicyYAML struct {
+	ScanExecutionPolicy []scanExecutionPolicyYAML `yaml:"scan_execution_policy,omitempty"`
+	ScanResultPolicy    []scanResultPolicyYAML    `yaml:"scan_result_policy,omitempty"`
+}
+
+type scanExecutionPolicyYAML struct {
+	Name        string                          `yaml:"name"`
+	Description *string                         `yaml:"description,omitempty"`
+	Enabled     bool                            `yaml:"enabled"`
+	Rules       []scanExecutionPolicyRuleYAML   `yaml:"rules"`
+	Actions     []scanExecutionPolicyActionYAML `yaml:"actions"`
+}
+
+type scanExecutionPolicyRuleYAML struct {
+	Type             string    `yaml:"type"`
+	Branches         *[]string `yaml:"branches,omitempty"`
+	BranchType       *string   `yaml:"branch_type,omitempty"`
+	BranchExceptions *[]string `yaml:"branch_exceptions,omitempty"`
+	Cadence          *string   `yaml:"cadence,omitempty"`
+	Timezone         *string   `yaml:"timezone,omitempty"`
+}
+
+type scanExecutionPolicyActionYAML struct {
+	Scan           string             `yaml:"scan"`
+	SiteProfile    *string            `yaml:"site_profile,omitempty"`
+	ScannerProfile *string            `yaml:"scanner_profile,omitempty"`
+	Tags           *[]string          `yaml:"tags,omitempty"`
+	Variables      *map[string]string `yaml:"variables,omitempty"`
+}
+
+type scanResultPolicyYAML struct {
+	Name        string                       `yaml:"name"`
+	Description *string                      `yaml:"description,omitempty"`
+	Enabled     bool                         `yaml:"enabled"`
+	Rules       []scanResultPolicyRuleYAML   `yaml:"rules"`
+	Actions     []scanResultPolicyActionYAML `yaml:"actions"`
+}
+
+type scanResultPolicyRuleYAML struct {
+	Type                   string    `yaml:"type"`
+	Branches               *[]string `yaml:"branches,omitempty"`
+	BranchType             *string   `yaml:"branch_type,omitempty"`
+	BranchExceptions       *[]string `yaml:"branch_exceptions,omitempty"`
+	Scanners               *[]string `yaml:"scanners,omitempty"`
+	VulnerabilitiesAllowed *int64    `yaml:"vulnerabilities_allowed,omitempty"`
+	SeverityLevels         *[]string `yaml:"severity_levels,omitempty"`
+	VulnerabilityStates    *[]string `yaml:"vulnerability_states,omitempty"`
+	MatchOnInclusion       *bool     `yaml:"match_on_inclusion,omitempty"`
+	LicenseTypes           *[]string `yaml:"license_types,omitempty"`
+	LicenseStates          *[]string `yaml:"license_states,omitempty"`
+}
+
+type scanResultPolicyActionYAML struct {
+	Type              string    `yaml:"type"`
+	ApprovalsRequired int64     `yaml:"approvals_required"`
+	UserApprovers     *[]string `yaml:"user_approvers,omitempty"`
+	UserApproversIds  *[]int64  `yaml:"user_approvers_ids,omitempty"`
+	GroupApprovers    *[]string `yaml:"group_approvers,omitempty"`
+	GroupApproversIds *[]int64  `yaml:"group_approvers_ids,omitempty"`
+	RoleApprovers     *[]string `yaml:"role_approvers,omitempty"`
+}
@@ -0,0 +1,141 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabSecurityPolicy_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testPolicyProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabSecurityPolicy_CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create a policy file with a scan execution policy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_security_policy" "foo" {
+						project = "%d"
+
+						scan_execution_policy {
+							name        = "Enforce secret detection"
+							description = "Run secret detection on the default branch"
+
+							rules {
+								type     = "pipeline"
+								branches = ["main"]
+							}
+
+							actions {
+								scan = "secret_detection"
+							}
+						}
+					}
+				`, testPolicyProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_security_policy.foo", "branch", testPolicyProject.DefaultBranch),
+					resource.TestCheckResourceAttr("gitlab_security_policy.foo", "scan_execution_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_security_policy.foo", "content"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_security_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the scan execution policy and add a scan result policy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_security_policy" "foo" {
+						project        = "%d"
+						commit_message = "Update policies from Terraform"
+
+						scan_execution_policy {
+							name    = "Enforce secret detection"
+							enabled = false
+
+							rules {
+								type        = "schedule"
+								branch_type = "protected"
+								cadence     = "0 0 * * *"
+							}
+
+							actions {
+								scan = "secret_detection"
+								tags = ["docker"]
+								variables = {
+									SECURE_ANALYZERS_PREFIX = "registry.example.com"
+								}
+							}
+						}
+
+						scan_result_policy {
+							name = "Require approval for critical vulnerabilities"
+
+							rules {
+								type                    = "scan_finding"
+								branch_type             = "default"
+								scanners                = ["container_scanning"]
+								vulnerabilities_allowed = 0
+								severity_levels         = ["critical"]
+								vulnerability_states    = ["newly_detected"]
+							}
+
+							actions {
+								type               = "require_approval"
+								approvals_required = 1
+								role_approvers     = ["maintainer"]
+							}
+						}
+					}
+				`, testPolicyProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_security_policy.foo", "scan_execution_policy.0.enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_security_policy.foo", "scan_result_policy.0.actions.0.approvals_required", "1"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_security_policy.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+		},
+	})
+}
+
+func testAcc_GitlabSecurityPolicy_CheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_security_policy" {
+			continue
+		}
+
+		project, branch, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = api.GetRepositoryFileContent(context.Background(), testutil.TestGitlabClient, project, securityPolicyFilePath, branch)
+		if err == nil {
+			return fmt.Errorf("Security policy file in project %s still exists", project)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
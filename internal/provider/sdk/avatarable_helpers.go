@@ -5,43 +5,72 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // avatarableSchema returns a resource schema with the attributes required to support Avatars for GitLab resources.
 func avatarableSchema() map[string]*schema.Schema {
+	return uploadableImageSchema("avatar", "avatar")
+}
+
+// avatarableDiff must be used to properly support the `avatarSchema` attributes in a resource Schema.
+func avatarableDiff(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
+	return uploadableImageDiff("avatar")(ctx, rd, i)
+}
+
+func handleAvatarOnCreate(d *schema.ResourceData) (*localAvatar, error) {
+	return handleUploadableImageOnCreate(d, "avatar")
+}
+
+func handleAvatarOnUpdate(d *schema.ResourceData) (*localAvatar, error) {
+	return handleUploadableImageOnUpdate(d, "avatar")
+}
+
+// uploadableImageSchema returns the `<name>`, `<name>_hash` and `<name>_url` attributes
+// required to upload a local image file to GitLab, e.g. an avatar or a logo.
+func uploadableImageSchema(name string, label string) map[string]*schema.Schema {
+	article := "a"
+	if strings.ContainsAny(label[:1], "aeiou") {
+		article = "an"
+	}
+
 	return map[string]*schema.Schema{
-		"avatar": {
-			Description: "A local path to the avatar image to upload. **Note**: not available for imported resources.",
+		name: {
+			Description: fmt.Sprintf("A local path to the %s image to upload. **Note**: not available for imported resources.", label),
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
-		"avatar_hash": {
-			Description:  "The hash of the avatar image. Use `filesha256(\"path/to/avatar.png\")` whenever possible. **Note**: this is used to trigger an update of the avatar. If it's not given, but an avatar is given, the avatar will be updated each time.",
+		name + "_hash": {
+			Description:  fmt.Sprintf("The hash of the %[1]s image. Use `filesha256(\"path/to/%[2]s.png\")` whenever possible. **Note**: this is used to trigger an update of the %[1]s. If it's not given, but %[3]s %[1]s is given, the %[1]s will be updated each time.", label, name, article),
 			Type:         schema.TypeString,
 			Optional:     true,
 			Computed:     true,
-			RequiredWith: []string{"avatar"},
+			RequiredWith: []string{name},
 		},
-		"avatar_url": {
-			Description: "The URL of the avatar image.",
+		name + "_url": {
+			Description: fmt.Sprintf("The URL of the %s image.", label),
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
 	}
 }
 
-// avatarableDiff must be used to properly support the `avatarSchema` attributes in a resource Schema.
-func avatarableDiff(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
-	if _, ok := rd.GetOk("avatar"); ok {
-		if v, ok := rd.GetOk("avatar_hash"); !ok || v.(string) == "" {
-			if err := rd.SetNewComputed("avatar_hash"); err != nil {
-				return err
+// uploadableImageDiff must be used to properly support the `uploadableImageSchema` attributes in a resource Schema.
+func uploadableImageDiff(names ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
+		for _, name := range names {
+			if _, ok := rd.GetOk(name); ok {
+				if v, ok := rd.GetOk(name + "_hash"); !ok || v.(string) == "" {
+					if err := rd.SetNewComputed(name + "_hash"); err != nil {
+						return err
+					}
+				}
 			}
 		}
+		return nil
 	}
-	return nil
 }
 
 type localAvatar struct {
@@ -49,42 +78,42 @@ type localAvatar struct {
 	Image    io.Reader
 }
 
-func handleAvatarOnCreate(d *schema.ResourceData) (*localAvatar, error) {
-	if v, ok := d.GetOk("avatar"); ok {
-		avatarPath := v.(string)
-		avatarFile, err := os.Open(avatarPath)
+func handleUploadableImageOnCreate(d *schema.ResourceData, name string) (*localAvatar, error) {
+	if v, ok := d.GetOk(name); ok {
+		imagePath := v.(string)
+		imageFile, err := os.Open(imagePath)
 		if err != nil {
-			return nil, fmt.Errorf("unable to open avatar file %s: %s", avatarPath, err)
+			return nil, fmt.Errorf("unable to open %s file %s: %s", name, imagePath, err)
 		}
 
 		return &localAvatar{
-			Filename: avatarPath,
-			Image:    avatarFile,
+			Filename: imagePath,
+			Image:    imageFile,
 		}, nil
 	}
 
 	return nil, nil
 }
 
-func handleAvatarOnUpdate(d *schema.ResourceData) (*localAvatar, error) {
-	avatar, isAvatarSet := d.GetOk("avatar")
-	if d.HasChanges("avatar", "avatar_hash") || (isAvatarSet && d.Get("avatar_hash").(string) == "") {
-		avatarPath := avatar.(string)
+func handleUploadableImageOnUpdate(d *schema.ResourceData, name string) (*localAvatar, error) {
+	image, isImageSet := d.GetOk(name)
+	if d.HasChanges(name, name+"_hash") || (isImageSet && d.Get(name+"_hash").(string) == "") {
+		imagePath := image.(string)
 
-		if avatarPath == "" { // the avatar should be removed
+		if imagePath == "" { // the image should be removed
 			// terraform doesn't care to remove this from state, thus, we do.
-			d.Set("avatar_hash", "")
+			d.Set(name+"_hash", "")
 
 			return &localAvatar{}, nil
-		} else { // the avatar should be added or changed
-			avatarFile, err := os.Open(avatarPath)
+		} else { // the image should be added or changed
+			imageFile, err := os.Open(imagePath)
 			if err != nil {
-				return nil, fmt.Errorf("unable to open avatar file %s: %s", avatarPath, err)
+				return nil, fmt.Errorf("unable to open %s file %s: %s", name, imagePath, err)
 			}
 
 			return &localAvatar{
-				Filename: avatarPath,
-				Image:    avatarFile,
+				Filename: imagePath,
+				Image:    imageFile,
 			}, nil
 		}
 	}
@@ -0,0 +1,57 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_access_requests", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_access_requests`" + ` data source allows to retrieve the pending access requests of a group.
+
+-> Use the ` + "`gitlab_group_access_request_approval`" + ` resource to approve an access request.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/access_requests.html#list-access-requests-for-a-group-or-project)`,
+
+		ReadContext: dataSourceGitlabGroupAccessRequestsRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"access_requests": gitlabAccessRequestsSchema(),
+		},
+	}
+})
+
+func dataSourceGitlabGroupAccessRequestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	groupID := d.Get("group_id").(string)
+	options := gitlab.ListAccessRequestsOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var accessRequests []*gitlab.AccessRequest
+	for options.Page != 0 {
+		paginatedAccessRequests, resp, err := client.AccessRequests.ListGroupAccessRequests(groupID, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accessRequests = append(accessRequests, paginatedAccessRequests...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(groupID)
+	if err := d.Set("access_requests", flattenGitlabAccessRequests(accessRequests)); err != nil {
+		return diag.Errorf("failed to set access requests to state: %v", err)
+	}
+
+	return nil
+}
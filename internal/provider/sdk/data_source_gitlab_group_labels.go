@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_labels", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_labels`" + ` data source allows to retrieve details about the labels of a group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_labels.html#list-group-labels)`,
+
+		ReadContext: dataSourceGitlabGroupLabelsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"include_ancestor_groups": {
+				Description: "Include the labels of the ancestor groups. Defaults to `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"search": {
+				Description: "Only return labels whose name contains the search term.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"labels": gitlabLabelsDataSourceSchema(),
+		},
+	}
+})
+
+func dataSourceGitlabGroupLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group := d.Get("group").(string)
+	labels, err := listGroupLabels(ctx, client, group, d.Get("include_ancestor_groups").(bool), d.Get("search").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("labels", flattenGitlabLabels(labels)); err != nil {
+		return diag.Errorf("failed to set labels to state: %v", err)
+	}
+
+	return nil
+}
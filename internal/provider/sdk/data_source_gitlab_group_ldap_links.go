@@ -0,0 +1,44 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_ldap_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_ldap_links`" + ` data source allows to retrieve the LDAP group links of a group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-ldap-group-links)`,
+
+		ReadContext: dataSourceGitlabGroupLdapLinksRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ldap_links": gitlabGroupLDAPLinkSetSchema(true),
+		},
+	}
+})
+
+func dataSourceGitlabGroupLdapLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group := d.Get("group").(string)
+	links, err := listGroupLDAPLinks(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("ldap_links", flattenGitlabGroupLDAPLinks(links)); err != nil {
+		return diag.Errorf("failed to set LDAP links to state: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_saml_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_saml_links`" + ` data source allows to retrieve the SAML group links of a group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-saml-group-links)`,
+
+		ReadContext: dataSourceGitlabGroupSamlLinksRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"saml_links": gitlabGroupSAMLLinkSetSchema(true),
+		},
+	}
+})
+
+func dataSourceGitlabGroupSamlLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group := d.Get("group").(string)
+	links, err := listGroupSAMLLinks(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("saml_links", flattenGitlabGroupSAMLLinks(links)); err != nil {
+		return diag.Errorf("failed to set SAML links to state: %v", err)
+	}
+
+	return nil
+}
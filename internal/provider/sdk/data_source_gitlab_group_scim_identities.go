@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_scim_identities", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_scim_identities`" + ` data source allows to retrieve the SCIM identities of a top-level group.
+
+-> This data source is only available on GitLab.com for top-level groups with SCIM provisioning enabled.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/scim.html#get-scim-identities-for-a-group)`,
+
+		ReadContext: dataSourceGitlabGroupScimIdentitiesRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"identities": gitlabGroupScimIdentitiesSchema(),
+		},
+	}
+})
+
+func dataSourceGitlabGroupScimIdentitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	identities, err := listGroupScimIdentities(ctx, client, group)
+	if err != nil {
+		return diag.Errorf("failed to list SCIM identities of group %s: %v", group, err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("identities", flattenGitlabGroupScimIdentities(identities)); err != nil {
+		return diag.Errorf("failed to set SCIM identities to state: %v", err)
+	}
+
+	return nil
+}
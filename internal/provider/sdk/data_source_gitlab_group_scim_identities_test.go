@@ -0,0 +1,36 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+// NOTE: SCIM identities are only available on GitLab.com, therefore the acceptance test
+// can only verify that the unavailable SCIM identities are reported.
+func TestAccDataSourceGitlabGroupScimIdentities_notAvailable(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_scim_identities" "this" {
+						group = "%d"
+					}
+				`, testGroup.ID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`failed to list SCIM identities of group %d`, testGroup.ID)),
+			},
+		},
+	})
+}
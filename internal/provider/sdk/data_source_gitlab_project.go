@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
@@ -421,11 +422,11 @@ var _ = registerDataSource("gitlab_project", func() *schema.Resource {
 var datasourceContainerExpirationPolicyAttributesSchema = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"cadence": {
-			Description:      fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(validContainerExpirationPolicyAttributesCadenceValues)),
+			Description:      fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidContainerExpirationPolicyCadenceValues)),
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validContainerExpirationPolicyAttributesCadenceValues, false)),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidContainerExpirationPolicyCadenceValues, false)),
 		},
 		"keep_n": {
 			Description:      "The number of images to keep.",
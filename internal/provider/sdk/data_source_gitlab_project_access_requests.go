@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_access_requests", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_access_requests`" + ` data source allows to retrieve the pending access requests of a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/access_requests.html#list-access-requests-for-a-group-or-project)`,
+
+		ReadContext: dataSourceGitlabProjectAccessRequestsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"access_requests": gitlabAccessRequestsSchema(),
+		},
+	}
+})
+
+func dataSourceGitlabProjectAccessRequestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+	options := gitlab.ListAccessRequestsOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var accessRequests []*gitlab.AccessRequest
+	for options.Page != 0 {
+		paginatedAccessRequests, resp, err := client.AccessRequests.ListProjectAccessRequests(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accessRequests = append(accessRequests, paginatedAccessRequests...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("access_requests", flattenGitlabAccessRequests(accessRequests)); err != nil {
+		return diag.Errorf("failed to set access requests to state: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccDataSourceGitlabProjectAccessRequests_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	user := testutil.CreateUsers(t, 1)[0]
+
+	token := testutil.CreatePersonalAccessToken(t, user)
+	client, err := gitlab.NewClient(token.Token, gitlab.WithBaseURL(testutil.TestGitlabClient.BaseURL().String()))
+	if err != nil {
+		t.Fatalf("could not create client for user %s: %v", user.Username, err)
+	}
+	if _, _, err := client.AccessRequests.RequestProjectAccess(project.ID); err != nil {
+		t.Fatalf("could not request access to project %d: %v", project.ID, err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_access_requests" "this" {
+						project = "%d"
+					}
+				`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_access_requests.this", "access_requests.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_access_requests.this", "access_requests.0.user_id", strconv.Itoa(user.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_access_requests.this", "access_requests.0.access_level", "developer"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerDataSource("gitlab_project_export", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_export`" + ` data source allows to export a project and download the export archive to the local file system, e.g. for backups.
+
+Every read schedules a new export, waits until it finished and overwrites the local file.
+The archive can be imported again with the ` + "`gitlab_project_import`" + ` resource.
+
+-> **Timeouts** Default timeout for *Read* is 20 minutes and can be configured in the ` + "`timeouts`" + ` block.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_import_export.html)`,
+
+		ReadContext: dataSourceGitlabProjectExportRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project to export.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"file": {
+				Description: "The local path to download the export archive (`.tar.gz`) to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Overrides the project description in the export.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"file_sha256": {
+				Description: "The SHA256 checksum of the downloaded export archive.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	file := d.Get("file").(string)
+
+	options := &gitlab.ScheduleExportOptions{}
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] schedule export of gitlab project %s", project)
+	if _, err := client.ProjectImportExport.ScheduleExport(project, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"none", "queued", "started", "regeneration_in_progress"},
+		Target:  []string{"finished"},
+		Timeout: d.Timeout(schema.TimeoutRead),
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := client.ProjectImportExport.ExportStatus(project, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+
+			return status, status.ExportStatus, nil
+		},
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error while waiting for project %q export to finish: %s", project, err)
+	}
+
+	log.Printf("[DEBUG] download export of gitlab project %s to %q", project, file)
+	archive, _, err := client.ProjectImportExport.ExportDownload(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := os.WriteFile(file, archive, 0600); err != nil {
+		return diag.Errorf("failed to write export archive to %q: %v", file, err)
+	}
+
+	checksum := sha256.Sum256(archive)
+
+	d.SetId(utils.BuildTwoPartID(&project, &file))
+	d.Set("file_sha256", hex.EncodeToString(checksum[:]))
+	return nil
+}
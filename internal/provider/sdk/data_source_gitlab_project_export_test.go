@@ -0,0 +1,48 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccDataSourceGitlabProjectExport_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	file := filepath.Join(t.TempDir(), "export.tar.gz")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_export" "this" {
+						project = "%d"
+						file    = "%s"
+					}
+				`, testProject.ID, file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.gitlab_project_export.this", "file_sha256"),
+					func(s *terraform.State) error {
+						info, err := os.Stat(file)
+						if err != nil {
+							return fmt.Errorf("export archive was not downloaded: %v", err)
+						}
+						if info.Size() == 0 {
+							return fmt.Errorf("export archive %q is empty", file)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
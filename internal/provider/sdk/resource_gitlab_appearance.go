@@ -0,0 +1,300 @@
+package sdk
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+const appearanceID = "gitlab"
+
+// The images of the appearance, mapped to a human readable label used in the docs.
+var appearanceImages = []struct {
+	Name  string
+	Label string
+}{
+	{"logo", "sign in and sign up page logo"},
+	{"header_logo", "navigation bar logo"},
+	{"favicon", "favicon"},
+	{"pwa_icon", "progressive web app icon"},
+}
+
+var _ = registerResource("gitlab_appearance", func() *schema.Resource {
+	imageSchemas := []map[string]*schema.Schema{}
+	imageNames := []string{}
+	for _, image := range appearanceImages {
+		imageSchemas = append(imageSchemas, uploadableImageSchema(image.Name, image.Label))
+		imageNames = append(imageNames, image.Name)
+	}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_appearance`" + ` resource allows to manage the appearance of a GitLab instance, like the title, logos and system header and footer messages.
+
+~> All ` + "`gitlab_appearance`" + ` use the same ID ` + "`gitlab`" + `.
+
+!> This resource does not implement any destroy logic, it's a no-op at this point.
+   It's also not possible to remove an uploaded image via the API, it can only be replaced.
+
+-> Requires administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/appearance.html)`,
+
+		CreateContext: resourceGitlabAppearanceSet,
+		ReadContext:   resourceGitlabAppearanceRead,
+		UpdateContext: resourceGitlabAppearanceSet,
+		DeleteContext: resourceGitlabAppearanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: uploadableImageDiff(imageNames...),
+
+		Schema: constructSchema(append([]map[string]*schema.Schema{{
+			"title": {
+				Description: "The instance title on the sign in and sign up page.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The markdown text shown on the sign in and sign up page.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"pwa_name": {
+				Description: "The full name of the progressive web app.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"pwa_short_name": {
+				Description: "The short name of the progressive web app.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"pwa_description": {
+				Description: "The description of the progressive web app.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"new_project_guidelines": {
+				Description: "The markdown text shown on the new project page.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"profile_image_guidelines": {
+				Description: "The markdown text shown on the profile page below the public avatar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"header_message": {
+				Description: "The message within the system header bar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"footer_message": {
+				Description: "The message within the system footer bar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"message_background_color": {
+				Description:      "The background color of the system header and footer bar, e.g. `#E75E40`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(appearanceColorRegex, "must be a hex color like `#E75E40`")),
+			},
+			"message_font_color": {
+				Description:      "The font color of the system header and footer bar, e.g. `#FFFFFF`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(appearanceColorRegex, "must be a hex color like `#FFFFFF`")),
+			},
+			"email_header_and_footer_enabled": {
+				Description: "Add the header and footer messages to all outgoing emails.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		}}, imageSchemas...)...),
+	}
+})
+
+var appearanceColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func resourceGitlabAppearanceSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &appearanceOptions{}
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("pwa_name") {
+		options.PwaName = gitlab.String(d.Get("pwa_name").(string))
+	}
+	if d.HasChange("pwa_short_name") {
+		options.PwaShortName = gitlab.String(d.Get("pwa_short_name").(string))
+	}
+	if d.HasChange("pwa_description") {
+		options.PwaDescription = gitlab.String(d.Get("pwa_description").(string))
+	}
+	if d.HasChange("new_project_guidelines") {
+		options.NewProjectGuidelines = gitlab.String(d.Get("new_project_guidelines").(string))
+	}
+	if d.HasChange("profile_image_guidelines") {
+		options.ProfileImageGuidelines = gitlab.String(d.Get("profile_image_guidelines").(string))
+	}
+	if d.HasChange("header_message") {
+		options.HeaderMessage = gitlab.String(d.Get("header_message").(string))
+	}
+	if d.HasChange("footer_message") {
+		options.FooterMessage = gitlab.String(d.Get("footer_message").(string))
+	}
+	if d.HasChange("message_background_color") {
+		options.MessageBackgroundColor = gitlab.String(d.Get("message_background_color").(string))
+	}
+	if d.HasChange("message_font_color") {
+		options.MessageFontColor = gitlab.String(d.Get("message_font_color").(string))
+	}
+	if d.HasChange("email_header_and_footer_enabled") {
+		options.EmailHeaderAndFooterEnabled = gitlab.Bool(d.Get("email_header_and_footer_enabled").(bool))
+	}
+
+	if (appearanceOptions{}) != *options {
+		log.Printf("[DEBUG] update GitLab appearance")
+		req, err := client.NewRequest(http.MethodPut, "application/appearance", options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return diag.Errorf("failed to update GitLab appearance: %s", err)
+		}
+	}
+
+	// The API accepts a single file per request, therefore each changed image is uploaded separately.
+	for _, image := range appearanceImages {
+		var upload *localAvatar
+		var err error
+		if d.IsNewResource() {
+			upload, err = handleUploadableImageOnCreate(d, image.Name)
+		} else {
+			upload, err = handleUploadableImageOnUpdate(d, image.Name)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// removing an image is not supported by the API
+		if upload == nil || upload.Image == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] upload GitLab appearance %s", image.Name)
+		req, err := client.UploadRequest(http.MethodPut, "application/appearance", upload.Image, upload.Filename, gitlab.UploadType(image.Name), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return diag.Errorf("failed to upload GitLab appearance %s: %s", image.Name, err)
+		}
+	}
+
+	d.SetId(appearanceID)
+	return resourceGitlabAppearanceRead(ctx, d, meta)
+}
+
+func resourceGitlabAppearanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Id() != appearanceID {
+		return diag.Errorf("The `gitlab_appearance` resource can only exist once and requires the id to be `gitlab`")
+	}
+
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] read GitLab appearance")
+
+	req, err := client.NewRequest(http.MethodGet, "application/appearance", nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var appearance gitlabAppearance
+	if _, err := client.Do(req, &appearance); err != nil {
+		return diag.Errorf("failed to read GitLab appearance: %s", err)
+	}
+
+	stateMap := map[string]interface{}{
+		"title":                           appearance.Title,
+		"description":                     appearance.Description,
+		"pwa_name":                        appearance.PwaName,
+		"pwa_short_name":                  appearance.PwaShortName,
+		"pwa_description":                 appearance.PwaDescription,
+		"new_project_guidelines":          appearance.NewProjectGuidelines,
+		"profile_image_guidelines":        appearance.ProfileImageGuidelines,
+		"header_message":                  appearance.HeaderMessage,
+		"footer_message":                  appearance.FooterMessage,
+		"message_background_color":        appearance.MessageBackgroundColor,
+		"message_font_color":              appearance.MessageFontColor,
+		"email_header_and_footer_enabled": appearance.EmailHeaderAndFooterEnabled,
+		"logo_url":                        appearance.Logo,
+		"header_logo_url":                 appearance.HeaderLogo,
+		"favicon_url":                     appearance.Favicon,
+		"pwa_icon_url":                    appearance.PwaIcon,
+	}
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabAppearanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] destroying the appearance does not yet do anything.")
+	return nil
+}
+
+// gitlabAppearance is the API representation of the appearance, which is not yet available in go-gitlab.
+type gitlabAppearance struct {
+	Title                       string `json:"title"`
+	Description                 string `json:"description"`
+	PwaName                     string `json:"pwa_name"`
+	PwaShortName                string `json:"pwa_short_name"`
+	PwaDescription              string `json:"pwa_description"`
+	PwaIcon                     string `json:"pwa_icon"`
+	Logo                        string `json:"logo"`
+	HeaderLogo                  string `json:"header_logo"`
+	Favicon                     string `json:"favicon"`
+	NewProjectGuidelines        string `json:"new_project_guidelines"`
+	ProfileImageGuidelines      string `json:"profile_image_guidelines"`
+	HeaderMessage               string `json:"header_message"`
+	FooterMessage               string `json:"footer_message"`
+	MessageBackgroundColor      string `json:"message_background_color"`
+	MessageFontColor            string `json:"message_font_color"`
+	EmailHeaderAndFooterEnabled bool   `json:"email_header_and_footer_enabled"`
+}
+
+type appearanceOptions struct {
+	Title                       *string `json:"title,omitempty"`
+	Description                 *string `json:"description,omitempty"`
+	PwaName                     *string `json:"pwa_name,omitempty"`
+	PwaShortName                *string `json:"pwa_short_name,omitempty"`
+	PwaDescription              *string `json:"pwa_description,omitempty"`
+	NewProjectGuidelines        *string `json:"new_project_guidelines,omitempty"`
+	ProfileImageGuidelines      *string `json:"profile_image_guidelines,omitempty"`
+	HeaderMessage               *string `json:"header_message,omitempty"`
+	FooterMessage               *string `json:"footer_message,omitempty"`
+	MessageBackgroundColor      *string `json:"message_background_color,omitempty"`
+	MessageFontColor            *string `json:"message_font_color,omitempty"`
+	EmailHeaderAndFooterEnabled *bool   `json:"email_header_and_footer_enabled,omitempty"`
+}
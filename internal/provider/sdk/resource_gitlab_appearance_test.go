@@ -0,0 +1,59 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabAppearance_basic(t *testing.T) {
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_appearance" "this" {
+						title                    = "Acceptance GitLab"
+						header_message           = "Welcome"
+						message_background_color = "#E75E40"
+						message_font_color       = "#FFFFFF"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "id", "gitlab"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "title", "Acceptance GitLab"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "header_message", "Welcome"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_appearance.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+					resource "gitlab_appearance" "this" {
+						title          = "Acceptance GitLab"
+						header_message = "Welcome back"
+						logo           = "${path.module}/testdata/avatarable/avatar.png"
+						logo_hash      = filesha256("${path.module}/testdata/avatarable/avatar.png")
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "header_message", "Welcome back"),
+					resource.TestCheckResourceAttrSet("gitlab_appearance.this", "logo_url"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_appearance.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"logo", "logo_hash"},
+			},
+		},
+	})
+}
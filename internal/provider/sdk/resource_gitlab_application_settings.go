@@ -3,10 +3,15 @@ package sdk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
@@ -27,6 +32,11 @@ var _ = registerResource("gitlab_application_settings", func() *schema.Resource
 !> This resource does not implement any destroy logic, it's a no-op at this point.
    It's also not possible to revert to the previous settings.
 
+-> With ` + "`" + `managed_fields_only` + "`" + ` only the settings given in the configuration are tracked, all other settings are left empty in the state
+   and changes to them are not shown as drift. With ` + "`" + `restore_original_values` + "`" + ` the settings which are removed from the
+   configuration are restored to the values they had before this resource started to manage them. Settings which were never
+   given in the configuration are never changed, there is no way to restore the GitLab defaults of the settings.
+
 -> Requires at administrative privileges on GitLab.
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/settings.html)`,
@@ -35,11 +45,126 @@ var _ = registerResource("gitlab_application_settings", func() *schema.Resource
 		ReadContext:   resourceGitlabApplicationSettingsRead,
 		UpdateContext: resourceGitlabApplicationSettingsSet,
 		DeleteContext: resourceGitlabApplicationSettingsDelete,
+		CustomizeDiff: resourceGitlabApplicationSettingsCustomizeDiff,
 
-		Schema: gitlabApplicationSettingsSchema(),
+		Schema:        gitlabApplicationSettingsResourceSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGitlabApplicationSettingsResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGitlabApplicationSettingsStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 })
 
+// applicationSettingsModeAttributes are the attributes of the resource which don't map to an application setting.
+var applicationSettingsModeAttributes = []string{"id", "managed_fields_only", "restore_original_values", "managed_attributes", "original_values", "drifted_attributes"}
+
+func gitlabApplicationSettingsResourceSchema() map[string]*schema.Schema {
+	s := gitlabApplicationSettingsSchema()
+	s["managed_fields_only"] = &schema.Schema{
+		Description: "Only track the settings given in the configuration. All other settings are left empty in the state and changes to them are not shown as drift.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["restore_original_values"] = &schema.Schema{
+		Description: "Restore the settings which are no longer given in the configuration to the values they had before this resource started to manage them, as recorded in `original_values`. A change is shown whenever one of these settings deviates from its recorded value, see `drifted_attributes`. Settings which were never given in the configuration are not changed and are not reset to their GitLab defaults.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	s["managed_attributes"] = &schema.Schema{
+		Description: "The names of the settings given in the configuration.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	}
+	s["original_values"] = &schema.Schema{
+		Description: "The JSON encoded values of the settings given in the configuration, as they were before this resource started to manage them.",
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Sensitive:   true,
+	}
+	s["drifted_attributes"] = &schema.Schema{
+		Description: "The names of the settings which are no longer given in the configuration and deviate from their value in `original_values`. They are restored with `restore_original_values`.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	}
+	return s
+}
+
+// resourceGitlabApplicationSettingsResourceV0 returns the V0 schema definition.
+// From V0-V1 the `managed_fields_only`, `restore_original_values`, `managed_attributes`, `original_values`
+// and `drifted_attributes` attributes were added, all other attributes were not impacted.
+func resourceGitlabApplicationSettingsResourceV0() *schema.Resource {
+	return &schema.Resource{Schema: gitlabApplicationSettingsSchema()}
+}
+
+// resourceGitlabApplicationSettingsStateUpgradeV0 performs the state migration from V0 to V1.
+// Existing states keep tracking all settings. The computed attributes are left empty,
+// see resourceGitlabApplicationSettingsCustomizeDiff for when they are recorded.
+func resourceGitlabApplicationSettingsStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	tflog.Debug(ctx, "attempting state migration from V0 to V1 - adding the `managed_fields_only` and `restore_original_values` attributes")
+	rawState["managed_fields_only"] = false
+	rawState["restore_original_values"] = false
+	return rawState, nil
+}
+
+// resourceGitlabApplicationSettingsCustomizeDiff records which settings are given in the configuration,
+// so that a setting removed from the configuration is no longer tracked, and plans the restore of drifted settings.
+func resourceGitlabApplicationSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	managed := applicationSettingsManagedAttributes(d.GetRawConfig())
+	if managed == nil {
+		return nil
+	}
+
+	current := *stringSetToStringSlice(d.Get("managed_attributes").(*schema.Set))
+	sort.Strings(current)
+
+	// NOTE: states upgraded from V0 don't have the managed settings recorded yet. As long as neither mode is enabled
+	//       they aren't needed, so we don't record them to not show a change for every existing resource.
+	if d.Id() != "" && len(current) == 0 && !d.Get("managed_fields_only").(bool) && !d.Get("restore_original_values").(bool) {
+		return nil
+	}
+
+	if d.Id() == "" || strings.Join(current, ",") != strings.Join(managed, ",") {
+		if err := d.SetNew("managed_attributes", managed); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("original_values"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("drifted_attributes")
+	}
+
+	if d.Get("restore_original_values").(bool) && d.Get("drifted_attributes").(*schema.Set).Len() > 0 {
+		return d.SetNewComputed("drifted_attributes")
+	}
+	return nil
+}
+
+// applicationSettingsManagedAttributes returns the sorted names of the settings given in the configuration.
+func applicationSettingsManagedAttributes(rawConfig cty.Value) []string {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	managed := []string{}
+	for name, value := range rawConfig.AsValueMap() {
+		if value.IsNull() || contains(applicationSettingsModeAttributes, name) {
+			continue
+		}
+		managed = append(managed, name)
+	}
+	sort.Strings(managed)
+	return managed
+}
+
 func resourceGitlabApplicationSettingsSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 
@@ -58,6 +183,27 @@ func resourceGitlabApplicationSettingsSet(ctx context.Context, d *schema.Resourc
 		options.EnabledGitAccessProtocol = nil
 	}
 
+	// Record the values of newly managed settings before changing them, so that they can be restored later on.
+	settings, _, err := client.Settings.GetSettings(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stateMap := gitlabApplicationSettingsToStateMap(settings)
+	managed := *stringSetToStringSlice(d.Get("managed_attributes").(*schema.Set))
+	// NOTE: the new value is unknown whenever the managed settings change, therefore, we take the recorded values from the state.
+	rawOriginalValues, _ := d.GetChange("original_values")
+	originalValues := rawOriginalValues.(map[string]interface{})
+	for _, name := range managed {
+		if _, ok := originalValues[name]; ok {
+			continue
+		}
+		value, err := json.Marshal(stateMap[name])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		originalValues[name] = string(value)
+	}
+
 	if (gitlab.UpdateSettingsOptions{}) != *options {
 		_, _, err := client.Settings.UpdateSettings(options, gitlab.WithContext(ctx))
 		if err != nil {
@@ -65,6 +211,27 @@ func resourceGitlabApplicationSettingsSet(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if d.Get("restore_original_values").(bool) {
+		resetOptions, err := driftedApplicationSettings(stateMap, originalValues, managed)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(resetOptions) > 0 {
+			log.Printf("[DEBUG] restore GitLab Application Settings to their original values: %v", resetOptions)
+			// NOTE: go-gitlab doesn't support to send arbitrary settings, therefore, we send the original values as they are.
+			req, err := client.NewRequest(http.MethodPut, "application/settings", resetOptions, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if _, err := client.Do(req, nil); err != nil {
+				return diag.Errorf("failed to restore GitLab Application Settings to their original values: %s", err)
+			}
+		}
+	}
+
+	if err := d.Set("original_values", originalValues); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(applicationSettingsID)
 	return resourceGitlabApplicationSettingsRead(ctx, d, meta)
 }
@@ -82,12 +249,58 @@ func resourceGitlabApplicationSettingsRead(ctx context.Context, d *schema.Resour
 	}
 
 	stateMap := gitlabApplicationSettingsToStateMap(settings)
+
+	managed := *stringSetToStringSlice(d.Get("managed_attributes").(*schema.Set))
+	drifted, err := driftedApplicationSettings(stateMap, d.Get("original_values").(map[string]interface{}), managed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	driftedNames := make([]string, 0, len(drifted))
+	for name := range drifted {
+		driftedNames = append(driftedNames, name)
+	}
+	if err := d.Set("drifted_attributes", driftedNames); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("managed_fields_only").(bool) {
+		for name := range stateMap {
+			if !contains(managed, name) {
+				stateMap[name] = nil
+			}
+		}
+	}
+
 	if err = setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
+// driftedApplicationSettings returns the settings which are no longer given in the configuration
+// and deviate from their recorded original value, mapped to that value.
+func driftedApplicationSettings(stateMap map[string]interface{}, originalValues map[string]interface{}, managed []string) (map[string]interface{}, error) {
+	drifted := map[string]interface{}{}
+	for name, originalValue := range originalValues {
+		if contains(managed, name) {
+			continue
+		}
+		value, err := json.Marshal(stateMap[name])
+		if err != nil {
+			return nil, err
+		}
+		if string(value) == originalValue.(string) {
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(originalValue.(string)), &decoded); err != nil {
+			return nil, fmt.Errorf("unable to decode the original value of %q: %w", name, err)
+		}
+		drifted[name] = decoded
+	}
+	return drifted, nil
+}
+
 func resourceGitlabApplicationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] destroying the application settings does not yet do anything.")
 	return nil
@@ -4,9 +4,16 @@
 package sdk
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
 )
 
 func TestAccGitlabApplicationSettings_basic(t *testing.T) {
@@ -101,3 +108,214 @@ func TestAccGitlabApplicationSettings_testNullGitProtocol(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGitlabApplicationSettings_managedFieldsOnly(t *testing.T) {
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only = true
+						after_sign_up_text  = "Welcome to GitLab!"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "after_sign_up_text", "Welcome to GitLab!"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "managed_attributes.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "managed_attributes.0", "after_sign_up_text"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "home_page_url", ""),
+				),
+			},
+			// Verify that changes to unmanaged settings are not shown as drift
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{HelpPageText: gitlab.String("Unmanaged help text")}); err != nil {
+						t.Fatalf("failed to update unmanaged setting: %v", err)
+					}
+				},
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only = true
+						after_sign_up_text  = "Welcome to GitLab!"
+					}
+				`,
+				PlanOnly: true,
+			},
+			// Verify that changes to managed settings are shown as drift
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{AfterSignUpText: gitlab.String("Changed outside of Terraform")}); err != nil {
+						t.Fatalf("failed to update managed setting: %v", err)
+					}
+				},
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only = true
+						after_sign_up_text  = "Welcome to GitLab!"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabApplicationSettings_restoreOriginalValues(t *testing.T) {
+	if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{HelpPageText: gitlab.String("Original help text")}); err != nil {
+		t.Fatalf("failed to update setting: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{HelpPageText: gitlab.String(""), HomePageURL: gitlab.String("")}); err != nil {
+			t.Fatalf("failed to reset settings: %v", err)
+		}
+	})
+
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			// Manage a setting, which records its original value
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only        = true
+						restore_original_values = true
+						after_sign_up_text         = "Welcome to GitLab!"
+						help_page_text             = "Managed help text"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "help_page_text", "Managed help text"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "original_values.help_page_text", `"Original help text"`),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "drifted_attributes.#", "0"),
+				),
+			},
+			// Verify that a setting removed from the configuration is restored to its original value
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only        = true
+						restore_original_values = true
+						after_sign_up_text         = "Welcome to GitLab!"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "restore_original_values", "true"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "drifted_attributes.#", "0"),
+					func(_ *terraform.State) error {
+						settings, _, err := testutil.TestGitlabClient.Settings.GetSettings()
+						if err != nil {
+							return err
+						}
+						if settings.HelpPageText != "Original help text" {
+							return fmt.Errorf("expected help_page_text to be restored to its original value, got %q", settings.HelpPageText)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify that deviations of previously managed settings are detected
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{HelpPageText: gitlab.String("Changed outside of Terraform")}); err != nil {
+						t.Fatalf("failed to update setting: %v", err)
+					}
+				},
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only        = true
+						restore_original_values = true
+						after_sign_up_text         = "Welcome to GitLab!"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Verify that the deviation is restored without touching settings which were never managed
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{HomePageURL: gitlab.String("https://example.com")}); err != nil {
+						t.Fatalf("failed to update setting: %v", err)
+					}
+				},
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						managed_fields_only        = true
+						restore_original_values = true
+						after_sign_up_text         = "Welcome to GitLab!"
+					}
+				`,
+				Check: func(_ *terraform.State) error {
+					settings, _, err := testutil.TestGitlabClient.Settings.GetSettings()
+					if err != nil {
+						return err
+					}
+					if settings.HelpPageText != "Original help text" {
+						return fmt.Errorf("expected help_page_text to be restored to its original value, got %q", settings.HelpPageText)
+					}
+					if settings.HomePageURL != "https://example.com" {
+						return fmt.Errorf("expected the never managed home_page_url to be unchanged, got %q", settings.HomePageURL)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccGitlabApplicationSettings_upgradeFromV0(t *testing.T) {
+	config := `
+		resource "gitlab_application_settings" "this" {
+			after_sign_up_text = "Welcome to GitLab!"
+		}
+	`
+
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			// Create the resource with a provider version using the V0 schema
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"gitlab": {
+						VersionConstraint: "~> 16.0.0",
+						Source:            "gitlabhq/gitlab",
+					},
+				},
+				Config: config,
+			},
+			// Verify that the upgraded state doesn't show a change
+			{
+				ProtoV6ProviderFactories: providerFactoriesV6,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func TestResourceGitlabApplicationSettings_StateUpgradeV0(t *testing.T) {
+	t.Parallel()
+
+	givenV0State := map[string]interface{}{
+		"id":                 "gitlab",
+		"after_sign_up_text": "Welcome to GitLab!",
+	}
+	expectedV1State := map[string]interface{}{
+		"id":                      "gitlab",
+		"after_sign_up_text":      "Welcome to GitLab!",
+		"managed_fields_only":     false,
+		"restore_original_values": false,
+	}
+
+	actualV1State, err := resourceGitlabApplicationSettingsStateUpgradeV0(context.Background(), givenV0State, nil)
+	if err != nil {
+		t.Fatalf("Error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expectedV1State, actualV1State) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expectedV1State, actualV1State)
+	}
+}
@@ -52,6 +52,9 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
    Having multiple ` + "`gitlab_branch_protection`" + ` resources for the same project and default branch will result in them overriding each other - make sure to only have a single one.
    This behavior might change in the future.
 
+-> Changes to the access levels are applied in-place without unprotecting the branch. GitLab CE doesn't support this,
+   thus the branch is unprotected and protected again there, leaving it unprotected for a moment.
+
 ~> The ` + "`allowed_to_push`" + `, ` + "`allowed_to_merge`" + `, ` + "`allowed_to_unprotect`" + `, ` + "`unprotect_access_level`" + ` and ` + "`code_owner_approval_required`" + ` attributes require a GitLab Enterprise instance.
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/protected_branches.html)`,
@@ -63,7 +66,7 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: constructSchema(map[string]*schema.Schema{
 			"project": {
 				Description: "The id of the project.",
 				Type:        schema.TypeString,
@@ -76,52 +79,7 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
 				ForceNew:    true,
 				Required:    true,
 			},
-			"merge_access_level": {
-				Description:      fmt.Sprintf("Access levels allowed to merge. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
-				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
-				Optional:         true,
-				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
-				ForceNew:         true,
-			},
-			"push_access_level": {
-				Description:      fmt.Sprintf("Access levels allowed to push. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
-				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
-				Optional:         true,
-				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
-				ForceNew:         true,
-			},
-			"unprotect_access_level": {
-				Description:      fmt.Sprintf("Access levels allowed to unprotect. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchUnprotectAccessLevelNames)),
-				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchUnprotectAccessLevelNames, false)),
-				Optional:         true,
-				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
-				ForceNew:         true,
-			},
-			"allow_force_push": {
-				Description: "Can be set to true to allow users with push access to force push.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				ForceNew:    true,
-			},
-			"allowed_to_push":      schemaAllowedTo(),
-			"allowed_to_merge":     schemaAllowedTo(),
-			"allowed_to_unprotect": schemaAllowedTo(),
-			"code_owner_approval_required": {
-				Description: "Can be set to true to require code owner approval before merging. Only available own Premium and Ultimate instances.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-			},
-			"branch_protection_id": {
-				Description: "The ID of the branch protection (not the branch name).",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
-		},
+		}, branchProtectionSchema()),
 	}
 })
 
@@ -155,33 +113,13 @@ func resourceGitlabBranchProtectionCreate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	mergeAccessLevel := api.AccessLevelNameToValue[d.Get("merge_access_level").(string)]
-	pushAccessLevel := api.AccessLevelNameToValue[d.Get("push_access_level").(string)]
-	unprotectAccessLevel := api.AccessLevelNameToValue[d.Get("unprotect_access_level").(string)]
-
-	allowForcePush := d.Get("allow_force_push").(bool)
-	codeOwnerApprovalRequired := d.Get("code_owner_approval_required").(bool)
-
-	allowedToPush := expandBranchPermissionOptions(d.Get("allowed_to_push").(*schema.Set).List())
-	allowedToMerge := expandBranchPermissionOptions(d.Get("allowed_to_merge").(*schema.Set).List())
-	allowedToUnprotect := expandBranchPermissionOptions(d.Get("allowed_to_unprotect").(*schema.Set).List())
-
-	pb, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project, &gitlab.ProtectRepositoryBranchesOptions{
-		Name:                      &branch,
-		PushAccessLevel:           &pushAccessLevel,
-		MergeAccessLevel:          &mergeAccessLevel,
-		UnprotectAccessLevel:      &unprotectAccessLevel,
-		AllowForcePush:            &allowForcePush,
-		AllowedToPush:             &allowedToPush,
-		AllowedToMerge:            &allowedToMerge,
-		AllowedToUnprotect:        &allowedToUnprotect,
-		CodeOwnerApprovalRequired: &codeOwnerApprovalRequired,
-	}, gitlab.WithContext(ctx))
+	options := expandProtectRepositoryBranchesOptions(d)
+	pb, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.Errorf("error protecting branch %q on project %q: %v", branch, project, err)
 	}
 
-	if !pb.CodeOwnerApprovalRequired && codeOwnerApprovalRequired {
+	if !pb.CodeOwnerApprovalRequired && *options.CodeOwnerApprovalRequired {
 		return diag.Errorf("feature unavailable: `code_owner_approval_required`, Premium or Ultimate license required.")
 	}
 
@@ -208,56 +146,17 @@ func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	d.Set("project", project)
-	d.Set("branch", pb.Name)
-
-	if pushAccessLevel, err := firstValidAccessLevel(pb.PushAccessLevels); err == nil {
-		if err := d.Set("push_access_level", api.AccessLevelValueToName[*pushAccessLevel]); err != nil {
-			return diag.Errorf("error setting push_access_level: %v", err)
-		}
-	}
-
-	if mergeAccessLevels, err := firstValidAccessLevel(pb.MergeAccessLevels); err == nil {
-		if err := d.Set("merge_access_level", api.AccessLevelValueToName[*mergeAccessLevels]); err != nil {
-			return diag.Errorf("error setting merge_access_level: %v", err)
-		}
-	}
-
-	if unprotectAccessLevels, err := firstValidAccessLevel(pb.UnprotectAccessLevels); err == nil {
-		if err := d.Set("unprotect_access_level", api.AccessLevelValueToName[*unprotectAccessLevels]); err != nil {
-			return diag.Errorf("error setting unprotect_access_level: %v", err)
-		}
-	}
-
-	if err := d.Set("allow_force_push", pb.AllowForcePush); err != nil {
-		return diag.Errorf("error setting allow_force_push: %v", err)
-	}
-
-	if err := d.Set("allowed_to_push", flattenNonZeroBranchAccessDescriptions(pb.PushAccessLevels)); err != nil {
-		return diag.Errorf("error setting allowed_to_push: %v", err)
-	}
-	if err := d.Set("allowed_to_merge", flattenNonZeroBranchAccessDescriptions(pb.MergeAccessLevels)); err != nil {
-		return diag.Errorf("error setting allowed_to_merge: %v", err)
-	}
-
-	if err := d.Set("allowed_to_unprotect", flattenNonZeroBranchAccessDescriptions(pb.UnprotectAccessLevels)); err != nil {
-		return diag.Errorf("error setting allowed_to_unprotect: %v", err)
-	}
 
-	if err := d.Set("code_owner_approval_required", pb.CodeOwnerApprovalRequired); err != nil {
-		return diag.Errorf("error setting code_owner_approval_required: %v", err)
+	if diags := setBranchProtectionToState(d, pb); diags.HasError() {
+		return diags
 	}
 
-	d.Set("branch_protection_id", pb.ID)
-
 	d.SetId(utils.BuildTwoPartID(&project, &pb.Name))
 
 	return nil
 }
 
 func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// NOTE: At the time of writing, the only value that does not force re-creation is code_owner_approval_required,
-	// so therefore that is the only update that needs to be handled.
-
 	client := meta.(*gitlab.Client)
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
@@ -265,11 +164,32 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] update gitlab branch protection for project %s, branch %s", project, branch)
 
-	options := &gitlab.UpdateProtectedBranchOptions{
-		CodeOwnerApprovalRequired: &codeOwnerApprovalRequired,
+	// The access levels are updated in-place by destroying and adding individual entries,
+	// because unprotecting and protecting the branch again would leave it unprotected for a moment.
+	// However, GitLab CE doesn't support updating the access levels, thus we have to re-protect the branch there.
+	if d.HasChanges("push_access_level", "merge_access_level", "unprotect_access_level", "allowed_to_push", "allowed_to_merge", "allowed_to_unprotect") {
+		isEnterprise, err := api.IsGitLabEnterprise(ctx, client)
+		if err != nil {
+			return diag.Errorf("error checking the GitLab edition: %v", err)
+		}
+		if !isEnterprise {
+			log.Printf("[WARN] GitLab CE doesn't support updating the access levels of protected branch %q in project %q in-place, re-protecting it", branch, project)
+			if _, err := client.ProtectedBranches.UnprotectRepositoryBranches(project, branch, gitlab.WithContext(ctx)); err != nil {
+				return diag.FromErr(err)
+			}
+			return resourceGitlabBranchProtectionCreate(ctx, d, meta)
+		}
+	}
+
+	existing, _, err := client.ProtectedBranches.GetProtectedBranch(project, branch, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("error looking up protected branch %q on project %q: %v", branch, project, err)
 	}
+
+	options := expandUpdateProtectedBranchOptions(d, existing)
 	featureNotAvailableError := diag.Errorf("feature unavailable: `code_owner_approval_required`, Premium or Ultimate license required.")
-	if _, _, err := client.ProtectedBranches.UpdateProtectedBranch(project, branch, options, gitlab.WithContext(ctx)); err != nil {
+	pb, _, err := client.ProtectedBranches.UpdateProtectedBranch(project, branch, options, gitlab.WithContext(ctx))
+	if err != nil {
 		// The user might be running a version of GitLab that does not support this feature.
 		// We enhance the generic 404 error with a more informative message.
 		if api.Is404(err) {
@@ -279,10 +199,6 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	// since 15.6 the endpoint is available, but `code_owner_approval_required` is still an enterprise feature
-	pb, _, err := client.ProtectedBranches.GetProtectedBranch(project, branch, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	if !pb.CodeOwnerApprovalRequired && codeOwnerApprovalRequired {
 		return featureNotAvailableError
 	}
@@ -334,7 +250,6 @@ func schemaAllowedTo() *schema.Schema {
 		Description: "Defines permissions for action.",
 		Type:        schema.TypeSet,
 		Optional:    true,
-		ForceNew:    true,
 		Elem:        allowedToElem,
 	}
 }
@@ -367,3 +282,224 @@ func flattenNonZeroBranchAccessDescriptions(descriptions []*gitlab.BranchAccessD
 
 	return values
 }
+
+// copyProtectRepositoryBranchesOptions builds the options to protect the branch `name` with the same settings as the existing protected branch `pb`.
+// All access levels are copied: the first role based access level of each kind is sent as the default access level,
+// so that GitLab doesn't add its own default, and all other entries are sent as `allowed_to_*` entries.
+func copyProtectRepositoryBranchesOptions(name string, pb *gitlab.ProtectedBranch) *gitlab.ProtectRepositoryBranchesOptions {
+	options := &gitlab.ProtectRepositoryBranchesOptions{
+		Name:                      gitlab.String(name),
+		AllowForcePush:            gitlab.Bool(pb.AllowForcePush),
+		CodeOwnerApprovalRequired: gitlab.Bool(pb.CodeOwnerApprovalRequired),
+	}
+
+	copyAccessLevels := func(descriptions []*gitlab.BranchAccessDescription) (*gitlab.AccessLevelValue, *[]*gitlab.BranchPermissionOptions) {
+		var accessLevel *gitlab.AccessLevelValue
+		allowedTo := make([]*gitlab.BranchPermissionOptions, 0)
+		for _, description := range descriptions {
+			switch {
+			case description.UserID != 0:
+				allowedTo = append(allowedTo, &gitlab.BranchPermissionOptions{UserID: gitlab.Int(description.UserID)})
+			case description.GroupID != 0:
+				allowedTo = append(allowedTo, &gitlab.BranchPermissionOptions{GroupID: gitlab.Int(description.GroupID)})
+			case accessLevel == nil:
+				accessLevel = gitlab.AccessLevel(description.AccessLevel)
+			default:
+				allowedTo = append(allowedTo, &gitlab.BranchPermissionOptions{AccessLevel: gitlab.AccessLevel(description.AccessLevel)})
+			}
+		}
+		return accessLevel, &allowedTo
+	}
+	options.PushAccessLevel, options.AllowedToPush = copyAccessLevels(pb.PushAccessLevels)
+	options.MergeAccessLevel, options.AllowedToMerge = copyAccessLevels(pb.MergeAccessLevels)
+	options.UnprotectAccessLevel, options.AllowedToUnprotect = copyAccessLevels(pb.UnprotectAccessLevels)
+
+	return options
+}
+
+// branchProtectionSchema returns the attributes shared by the project and group branch protection resources.
+func branchProtectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"merge_access_level": {
+			Description:      fmt.Sprintf("Access levels allowed to merge. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
+			Optional:         true,
+			Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+		},
+		"push_access_level": {
+			Description:      fmt.Sprintf("Access levels allowed to push. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
+			Optional:         true,
+			Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+		},
+		"unprotect_access_level": {
+			Description:      fmt.Sprintf("Access levels allowed to unprotect. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchUnprotectAccessLevelNames)),
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchUnprotectAccessLevelNames, false)),
+			Optional:         true,
+			Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+		},
+		"allow_force_push": {
+			Description: "Can be set to true to allow users with push access to force push.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"allowed_to_push":      schemaAllowedTo(),
+		"allowed_to_merge":     schemaAllowedTo(),
+		"allowed_to_unprotect": schemaAllowedTo(),
+		"code_owner_approval_required": {
+			Description: "Can be set to true to require code owner approval before merging. Only available own Premium and Ultimate instances.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"branch_protection_id": {
+			Description: "The ID of the branch protection (not the branch name).",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
+
+func expandProtectRepositoryBranchesOptions(d *schema.ResourceData) *gitlab.ProtectRepositoryBranchesOptions {
+	mergeAccessLevel := api.AccessLevelNameToValue[d.Get("merge_access_level").(string)]
+	pushAccessLevel := api.AccessLevelNameToValue[d.Get("push_access_level").(string)]
+	unprotectAccessLevel := api.AccessLevelNameToValue[d.Get("unprotect_access_level").(string)]
+
+	allowedToPush := expandBranchPermissionOptions(d.Get("allowed_to_push").(*schema.Set).List())
+	allowedToMerge := expandBranchPermissionOptions(d.Get("allowed_to_merge").(*schema.Set).List())
+	allowedToUnprotect := expandBranchPermissionOptions(d.Get("allowed_to_unprotect").(*schema.Set).List())
+
+	return &gitlab.ProtectRepositoryBranchesOptions{
+		Name:                      gitlab.String(d.Get("branch").(string)),
+		PushAccessLevel:           &pushAccessLevel,
+		MergeAccessLevel:          &mergeAccessLevel,
+		UnprotectAccessLevel:      &unprotectAccessLevel,
+		AllowForcePush:            gitlab.Bool(d.Get("allow_force_push").(bool)),
+		AllowedToPush:             &allowedToPush,
+		AllowedToMerge:            &allowedToMerge,
+		AllowedToUnprotect:        &allowedToUnprotect,
+		CodeOwnerApprovalRequired: gitlab.Bool(d.Get("code_owner_approval_required").(bool)),
+	}
+}
+
+// expandUpdateProtectedBranchOptions builds the options to update the existing protected branch `pb` in-place
+// to the configuration in `d`, so that the branch is never unprotected during an update.
+func expandUpdateProtectedBranchOptions(d *schema.ResourceData, pb *gitlab.ProtectedBranch) *gitlab.UpdateProtectedBranchOptions {
+	options := &gitlab.UpdateProtectedBranchOptions{
+		CodeOwnerApprovalRequired: gitlab.Bool(d.Get("code_owner_approval_required").(bool)),
+	}
+
+	if d.HasChange("allow_force_push") {
+		options.AllowForcePush = gitlab.Bool(d.Get("allow_force_push").(bool))
+	}
+	if d.HasChanges("push_access_level", "allowed_to_push") {
+		allowedToPush := expandUpdateBranchPermissionOptions(d.Get("push_access_level").(string), d.Get("allowed_to_push").(*schema.Set).List(), pb.PushAccessLevels)
+		options.AllowedToPush = &allowedToPush
+	}
+	if d.HasChanges("merge_access_level", "allowed_to_merge") {
+		allowedToMerge := expandUpdateBranchPermissionOptions(d.Get("merge_access_level").(string), d.Get("allowed_to_merge").(*schema.Set).List(), pb.MergeAccessLevels)
+		options.AllowedToMerge = &allowedToMerge
+	}
+	if d.HasChanges("unprotect_access_level", "allowed_to_unprotect") {
+		allowedToUnprotect := expandUpdateBranchPermissionOptions(d.Get("unprotect_access_level").(string), d.Get("allowed_to_unprotect").(*schema.Set).List(), pb.UnprotectAccessLevels)
+		options.AllowedToUnprotect = &allowedToUnprotect
+	}
+
+	return options
+}
+
+// expandUpdateBranchPermissionOptions diffs the configured access level and `allowed_to_*` entries against the
+// existing access descriptions of a protected branch. Entries which are not configured anymore are destroyed
+// by their ID and entries which don't exist yet are added.
+func expandUpdateBranchPermissionOptions(accessLevel string, allowedTo []interface{}, existing []*gitlab.BranchAccessDescription) []*gitlab.BranchPermissionOptions {
+	branchPermissionKey := func(accessLevel gitlab.AccessLevelValue, userID, groupID int) string {
+		switch {
+		case userID != 0:
+			return fmt.Sprintf("user:%d", userID)
+		case groupID != 0:
+			return fmt.Sprintf("group:%d", groupID)
+		default:
+			return fmt.Sprintf("access_level:%d", accessLevel)
+		}
+	}
+
+	wanted := make(map[string]*gitlab.BranchPermissionOptions)
+	level := api.AccessLevelNameToValue[accessLevel]
+	wanted[branchPermissionKey(level, 0, 0)] = &gitlab.BranchPermissionOptions{AccessLevel: &level}
+	for _, opt := range expandBranchPermissionOptions(allowedTo) {
+		var userID, groupID int
+		if opt.UserID != nil {
+			userID = *opt.UserID
+		}
+		if opt.GroupID != nil {
+			groupID = *opt.GroupID
+		}
+		wanted[branchPermissionKey(0, userID, groupID)] = opt
+	}
+
+	result := make([]*gitlab.BranchPermissionOptions, 0)
+	for _, description := range existing {
+		key := branchPermissionKey(description.AccessLevel, description.UserID, description.GroupID)
+		if _, ok := wanted[key]; ok {
+			delete(wanted, key)
+			continue
+		}
+		result = append(result, &gitlab.BranchPermissionOptions{
+			ID:      gitlab.Int(description.ID),
+			Destroy: gitlab.Bool(true),
+		})
+	}
+	for _, opt := range wanted {
+		result = append(result, opt)
+	}
+	return result
+}
+
+// setBranchProtectionToState sets the attributes shared by the project and group branch protection resources.
+func setBranchProtectionToState(d *schema.ResourceData, pb *gitlab.ProtectedBranch) diag.Diagnostics {
+	d.Set("branch", pb.Name)
+
+	if pushAccessLevel, err := firstValidAccessLevel(pb.PushAccessLevels); err == nil {
+		if err := d.Set("push_access_level", api.AccessLevelValueToName[*pushAccessLevel]); err != nil {
+			return diag.Errorf("error setting push_access_level: %v", err)
+		}
+	}
+
+	if mergeAccessLevels, err := firstValidAccessLevel(pb.MergeAccessLevels); err == nil {
+		if err := d.Set("merge_access_level", api.AccessLevelValueToName[*mergeAccessLevels]); err != nil {
+			return diag.Errorf("error setting merge_access_level: %v", err)
+		}
+	}
+
+	if unprotectAccessLevels, err := firstValidAccessLevel(pb.UnprotectAccessLevels); err == nil {
+		if err := d.Set("unprotect_access_level", api.AccessLevelValueToName[*unprotectAccessLevels]); err != nil {
+			return diag.Errorf("error setting unprotect_access_level: %v", err)
+		}
+	}
+
+	if err := d.Set("allow_force_push", pb.AllowForcePush); err != nil {
+		return diag.Errorf("error setting allow_force_push: %v", err)
+	}
+
+	if err := d.Set("allowed_to_push", flattenNonZeroBranchAccessDescriptions(pb.PushAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_push: %v", err)
+	}
+	if err := d.Set("allowed_to_merge", flattenNonZeroBranchAccessDescriptions(pb.MergeAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_merge: %v", err)
+	}
+
+	if err := d.Set("allowed_to_unprotect", flattenNonZeroBranchAccessDescriptions(pb.UnprotectAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_unprotect: %v", err)
+	}
+
+	if err := d.Set("code_owner_approval_required", pb.CodeOwnerApprovalRequired); err != nil {
+		return diag.Errorf("error setting code_owner_approval_required: %v", err)
+	}
+
+	d.Set("branch_protection_id", pb.ID)
+	return nil
+}
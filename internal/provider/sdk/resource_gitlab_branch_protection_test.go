@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -695,6 +697,122 @@ func TestAccGitlabBranchProtection_createForProjectDefaultBranch(t *testing.T) {
 	})
 }
 
+func TestAccGitlabBranchProtection_updateInPlace(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProject := testutil.CreateProject(t)
+	testUser := testutil.CreateUsers(t, 1)[0]
+	testutil.AddProjectMembers(t, testProject.ID, []*gitlab.User{testUser})
+
+	var protectedBranch gitlab.ProtectedBranch
+	var branchProtectionID int
+	var unprotected atomic.Bool
+	stopWatching := make(chan struct{})
+	t.Cleanup(func() { close(stopWatching) })
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabBranchProtectionDestroy,
+		Steps: []resource.TestStep{
+			// Protect a branch
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_branch_protection" "this" {
+						project            = %d
+						branch             = "in-place"
+						push_access_level  = "maintainer"
+						merge_access_level = "maintainer"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabBranchProtectionExists("gitlab_branch_protection.this", &protectedBranch),
+					func(_ *terraform.State) error {
+						branchProtectionID = protectedBranch.ID
+						return nil
+					},
+				),
+			},
+			// Update the access levels while watching that the branch never becomes unprotected
+			{
+				PreConfig: func() {
+					go func() {
+						for {
+							select {
+							case <-stopWatching:
+								return
+							case <-time.After(100 * time.Millisecond):
+								if _, _, err := testutil.TestGitlabClient.ProtectedBranches.GetProtectedBranch(testProject.ID, "in-place"); api.Is404(err) {
+									unprotected.Store(true)
+								}
+							}
+						}
+					}()
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_branch_protection" "this" {
+						project            = %d
+						branch             = "in-place"
+						push_access_level  = "no one"
+						merge_access_level = "developer"
+						allow_force_push   = true
+
+						allowed_to_push {
+							user_id = %d
+						}
+					}
+				`, testProject.ID, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					func(_ *terraform.State) error {
+						if unprotected.Load() {
+							return fmt.Errorf("branch was unprotected during the update")
+						}
+						return nil
+					},
+					testAccCheckGitlabBranchProtectionExists("gitlab_branch_protection.this", &protectedBranch),
+					testAccCheckGitlabBranchProtectionPersistsInStateCorrectly("gitlab_branch_protection.this", &protectedBranch),
+					func(_ *terraform.State) error {
+						if protectedBranch.ID != branchProtectionID {
+							return fmt.Errorf("branch protection was re-created: ID changed from %d to %d", branchProtectionID, protectedBranch.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "push_access_level", "no one"),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "merge_access_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "allowed_to_push.#", "1"),
+				),
+			},
+			// Remove the `allowed_to_push` entry again
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_branch_protection" "this" {
+						project            = %d
+						branch             = "in-place"
+						push_access_level  = "no one"
+						merge_access_level = "developer"
+						allow_force_push   = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabBranchProtectionExists("gitlab_branch_protection.this", &protectedBranch),
+					func(_ *terraform.State) error {
+						if protectedBranch.ID != branchProtectionID {
+							return fmt.Errorf("branch protection was re-created: ID changed from %d to %d", branchProtectionID, protectedBranch.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "allowed_to_push.#", "0"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_branch_protection.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabBranchProtectionPersistsInStateCorrectly(n string, pb *gitlab.ProtectedBranch) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -16,6 +16,11 @@ import (
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
+var validGroupDeletionModes = []string{
+	"soft",
+	"permanent",
+}
+
 var _ = registerResource("gitlab_group", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_group`" + ` resource allows to manage the lifecycle of a group.
@@ -180,6 +185,8 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
 			},
+			"deletion_mode":            deletionModeSchema("group", validGroupDeletionModes, "`permanent` requires GitLab 16.0 or later. Defaults to `soft`."),
+			"restore_pending_deletion": restorePendingDeletionSchema("group"),
 		}, avatarableSchema()),
 		CustomizeDiff: avatarableDiff,
 	}
@@ -187,6 +194,23 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 
 func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
+
+	if d.Get("restore_pending_deletion").(bool) {
+		pendingDeletion, err := resourceGitlabGroupFindPendingDeletion(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if pendingDeletion != nil {
+			log.Printf("[DEBUG] restore gitlab group %q pending deletion", pendingDeletion.FullPath)
+			if _, _, err := client.Groups.RestoreGroup(pendingDeletion.ID, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("failed to restore group %q pending deletion: %v", pendingDeletion.FullPath, err)
+			}
+
+			d.SetId(fmt.Sprintf("%d", pendingDeletion.ID))
+			return resourceGitlabGroupUpdate(ctx, d, meta)
+		}
+	}
+
 	options := &gitlab.CreateGroupOptions{
 		Name: gitlab.String(d.Get("name").(string)),
 	}
@@ -294,6 +318,12 @@ func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	group, _, err := client.Groups.CreateGroup(options, gitlab.WithContext(ctx))
 	if err != nil {
+		// A group pending deletion keeps its path, therefore, we check if it is the reason for the conflict.
+		if strings.Contains(err.Error(), "has already been taken") {
+			if pendingDeletion, findErr := resourceGitlabGroupFindPendingDeletion(ctx, client, d); findErr == nil && pendingDeletion != nil {
+				return diag.Errorf("group %q is pending deletion and blocks its path, set `restore_pending_deletion` to `true` to restore it or remove it permanently", pendingDeletion.FullPath)
+			}
+		}
 		return diag.FromErr(err)
 	}
 
@@ -519,7 +549,8 @@ func resourceGitlabGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("parent_id") {
+	// NOTE: a group pending deletion which is restored on create is already in the configured parent group.
+	if d.HasChange("parent_id") && !d.IsNewResource() {
 		err = transferSubGroup(ctx, d, client)
 		if err != nil {
 			return diag.FromErr(err)
@@ -529,6 +560,30 @@ func resourceGitlabGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceGitlabGroupRead(ctx, d, meta)
 }
 
+// resourceGitlabGroupFindPendingDeletion returns the group pending deletion at the configured path, if any.
+func resourceGitlabGroupFindPendingDeletion(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) (*gitlab.Group, error) {
+	fullPath := d.Get("path").(string)
+	if v, ok := d.GetOk("parent_id"); ok {
+		parent, _, err := client.Groups.GetGroup(v.(int), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		fullPath = fmt.Sprintf("%s/%s", parent.FullPath, fullPath)
+	}
+
+	group, _, err := client.Groups.GetGroup(fullPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if group.MarkedForDeletionOn == nil {
+		return nil, nil
+	}
+	return group, nil
+}
+
 func transferSubGroup(ctx context.Context, d *schema.ResourceData, client *gitlab.Client) error {
 	o, n := d.GetChange("parent_id")
 	parentId, ok := n.(int)
@@ -561,6 +616,20 @@ func resourceGitlabGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error deleting group %s: %s", d.Id(), err)
 	}
 
+	deletionMode := d.Get("deletion_mode").(string)
+	if deletionMode == "permanent" {
+		group, _, err := client.Groups.GetGroup(d.Id(), nil, gitlab.WithContext(ctx))
+		if err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+		if err == nil && group.MarkedForDeletionOn != nil {
+			log.Printf("[DEBUG] Permanently remove gitlab group %s marked for deletion", d.Id())
+			if err := permanentlyRemove(ctx, client, "groups", d.Id(), group.FullPath); err != nil {
+				return diag.Errorf("failed to permanently remove group %s: %v", d.Id(), err)
+			}
+		}
+	}
+
 	// Wait for the group to be deleted.
 	// Deleting a group in gitlab is async.
 	stateConf := &retry.StateChangeConf{
@@ -575,7 +644,7 @@ func resourceGitlabGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 				log.Printf("[ERROR] Received error: %#v", err)
 				return out, "Error", err
 			}
-			if out.MarkedForDeletionOn != nil {
+			if out.MarkedForDeletionOn != nil && deletionMode != "permanent" {
 				// Represents a Gitlab EE soft-delete
 				return out, "Deleted", nil
 			}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_access_request_approval", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_access_request_approval`" + ` resource allows to approve a pending access request of a group,
+e.g. one found with the ` + "`gitlab_group_access_requests`" + ` data source.
+
+Once approved, the user is a member of the group. The resource is removed from the state when the user is no longer a member.
+
+-> Destroying this resource removes the user from the group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/access_requests.html#approve-an-access-request)`,
+
+		CreateContext: resourceGitlabGroupAccessRequestApprovalCreate,
+		ReadContext:   resourceGitlabGroupAccessRequestApprovalRead,
+		DeleteContext: resourceGitlabGroupAccessRequestApprovalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"user_id": {
+				Description: "The id of the user who requested access.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"access_level": {
+				Description:      fmt.Sprintf("The access level to grant the user. Valid values are: %s. Defaults to `developer`.", utils.RenderValueListForDocs(api.ValidGroupAccessLevelNames)),
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidGroupAccessLevelNames, false)),
+				ForceNew:         true,
+				Optional:         true,
+				Default:          "developer",
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupAccessRequestApprovalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	groupID := d.Get("group_id").(string)
+	userID := d.Get("user_id").(int)
+
+	options := &gitlab.ApproveAccessRequestOptions{
+		AccessLevel: gitlab.AccessLevel(api.AccessLevelNameToValue[d.Get("access_level").(string)]),
+	}
+
+	log.Printf("[DEBUG] approve gitlab group access request of user %d for %s", userID, groupID)
+	if _, _, err := client.AccessRequests.ApproveGroupAccessRequest(groupID, userID, options, gitlab.WithContext(ctx)); err != nil {
+		if api.Is404(err) {
+			return diag.Errorf("no pending access request of user %d found for group %s", userID, groupID)
+		}
+		return diag.FromErr(err)
+	}
+
+	userIDForID := strconv.Itoa(userID)
+	d.SetId(utils.BuildTwoPartID(&groupID, &userIDForID))
+	return resourceGitlabGroupAccessRequestApprovalRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupAccessRequestApprovalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	groupID, userID, err := groupIdAndUserIdFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupMember, _, err := client.GroupMembers.GetGroupMember(groupID, userID, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] user %d is no longer a member of gitlab group %s, removing approval from state", userID, groupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("user_id", userID)
+	d.Set("access_level", api.AccessLevelValueToName[groupMember.AccessLevel])
+	return nil
+}
+
+func resourceGitlabGroupAccessRequestApprovalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	groupID, userID, err := groupIdAndUserIdFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] remove approved user %d from gitlab group %s", userID, groupID)
+	if _, err := client.GroupMembers.RemoveGroupMember(groupID, userID, nil, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupAccessRequestApproval_basic(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+	user := testutil.CreateUsers(t, 1)[0]
+	testAccGitlabRequestGroupAccess(t, group, user)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupAccessRequestApprovalDestroy,
+		Steps: []resource.TestStep{
+			// List the pending access requests
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_access_requests" "this" {
+						group_id = "%d"
+					}
+				`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_access_requests.this", "access_requests.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_requests.this", "access_requests.0.user_id", strconv.Itoa(user.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_requests.this", "access_requests.0.username", user.Username),
+					resource.TestCheckResourceAttrSet("data.gitlab_group_access_requests.this", "access_requests.0.requested_at"),
+				),
+			},
+			// Approve the access request
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_access_request_approval" "this" {
+						group_id     = "%d"
+						user_id      = %d
+						access_level = "reporter"
+					}
+				`, group.ID, user.ID),
+				Check: func(s *terraform.State) error {
+					member, _, err := testutil.TestGitlabClient.GroupMembers.GetGroupMember(group.ID, user.ID)
+					if err != nil {
+						return err
+					}
+					if member.AccessLevel != gitlab.ReporterPermissions {
+						return fmt.Errorf("expected access level reporter, got %d", member.AccessLevel)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "gitlab_group_access_request_approval.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupAccessRequestApproval_noPendingRequest(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+	user := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupAccessRequestApprovalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_access_request_approval" "this" {
+						group_id = "%d"
+						user_id  = %d
+					}
+				`, group.ID, user.ID),
+				ExpectError: regexp.MustCompile(`no pending access request`),
+			},
+		},
+	})
+}
+
+// testAccGitlabRequestGroupAccess requests access to the group as the given user.
+func testAccGitlabRequestGroupAccess(t *testing.T, group *gitlab.Group, user *gitlab.User) {
+	t.Helper()
+
+	token := testutil.CreatePersonalAccessToken(t, user)
+	client, err := gitlab.NewClient(token.Token, gitlab.WithBaseURL(testutil.TestGitlabClient.BaseURL().String()))
+	if err != nil {
+		t.Fatalf("could not create client for user %s: %v", user.Username, err)
+	}
+	if _, _, err := client.AccessRequests.RequestGroupAccess(group.ID); err != nil {
+		t.Fatalf("could not request access to group %d as user %s: %v", group.ID, user.Username, err)
+	}
+}
+
+func testAccCheckGitlabGroupAccessRequestApprovalDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_access_request_approval" {
+			continue
+		}
+
+		groupID, userID, err := groupIdAndUserIdFromId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testutil.TestGitlabClient.GroupMembers.GetGroupMember(groupID, userID)
+		if err == nil {
+			return fmt.Errorf("user %d is still a member of group %s", userID, groupID)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_branch_protection", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_branch_protection`" + ` resource allows to manage the lifecycle of a protected branch of a group.
+A group protected branch applies to all projects in the group, e.g. to protect ` + "`main`" + ` and ` + "`release/*`" + ` in every project
+without having a ` + "`gitlab_branch_protection`" + ` resource per project.
+
+-> This resource requires GitLab 15.9 or newer and a GitLab Premium or Ultimate license. Only top-level groups are supported.
+
+~> The ` + "`allowed_to_push`" + `, ` + "`allowed_to_merge`" + ` and ` + "`allowed_to_unprotect`" + ` blocks only support ` + "`group_id`" + ` and ` + "`access_level`" + ` for group protected branches.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_protected_branches.html)`,
+
+		CreateContext: resourceGitlabGroupBranchProtectionCreate,
+		ReadContext:   resourceGitlabGroupBranchProtectionRead,
+		UpdateContext: resourceGitlabGroupBranchProtectionUpdate,
+		DeleteContext: resourceGitlabGroupBranchProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// NOTE: the shared `allowed_to_*` blocks also have a `user_id`, which group protected branches don't support.
+			for _, key := range []string{"allowed_to_push", "allowed_to_merge", "allowed_to_unprotect"} {
+				for _, v := range d.Get(key).(*schema.Set).List() {
+					if userID, ok := v.(map[string]interface{})["user_id"]; ok && userID != 0 {
+						return fmt.Errorf("`user_id` is not supported in `%s` of a group branch protection, use `group_id` or `access_level` instead", key)
+					}
+				}
+			}
+			return nil
+		},
+		Schema: constructSchema(map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description:      "Name of the branch. Wildcards like `release/*` are supported.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateProtectedBranchNameFunc),
+			},
+		}, branchProtectionSchema()),
+	}
+})
+
+func resourceGitlabGroupBranchProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	branch := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] create gitlab group branch protection on branch %q for group %s", branch, group)
+
+	// NOTE: go-gitlab doesn't support group protected branches yet.
+	options := expandProtectRepositoryBranchesOptions(d)
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/protected_branches", gitlab.PathEscape(group)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	pb := new(gitlab.ProtectedBranch)
+	if _, err := client.Do(req, pb); err != nil {
+		return diag.Errorf("error protecting branch %q on group %q: %v", branch, group, err)
+	}
+
+	if !pb.CodeOwnerApprovalRequired && *options.CodeOwnerApprovalRequired {
+		return diag.Errorf("feature unavailable: `code_owner_approval_required`, Premium or Ultimate license required.")
+	}
+
+	d.SetId(utils.BuildTwoPartID(&group, &pb.Name))
+
+	return resourceGitlabGroupBranchProtectionRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupBranchProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, branch, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab group branch protection for group %s, branch %s", group, branch)
+
+	pb, err := getGroupProtectedBranch(ctx, client, group, branch)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab group branch protection for group %s, branch %s not found, removing from state", group, branch)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+
+	if diags := setBranchProtectionToState(d, pb); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupBranchProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, branch, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	codeOwnerApprovalRequired := d.Get("code_owner_approval_required").(bool)
+
+	log.Printf("[DEBUG] update gitlab group branch protection for group %s, branch %s", group, branch)
+
+	existing, err := getGroupProtectedBranch(ctx, client, group, branch)
+	if err != nil {
+		return diag.Errorf("error looking up protected branch %q on group %q: %v", branch, group, err)
+	}
+
+	options := expandUpdateProtectedBranchOptions(d, existing)
+	req, err := client.NewRequest(http.MethodPatch, fmt.Sprintf("groups/%s/protected_branches/%s", gitlab.PathEscape(group), gitlab.PathEscape(branch)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	pb := new(gitlab.ProtectedBranch)
+	if _, err := client.Do(req, pb); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !pb.CodeOwnerApprovalRequired && codeOwnerApprovalRequired {
+		return diag.Errorf("feature unavailable: `code_owner_approval_required`, Premium or Ultimate license required.")
+	}
+
+	return resourceGitlabGroupBranchProtectionRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupBranchProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, branch, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Delete gitlab protected branch %s for group %s", branch, group)
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/protected_branches/%s", gitlab.PathEscape(group), gitlab.PathEscape(branch)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// getGroupProtectedBranch gets a single protected branch or wildcard protected branch of a group.
+func getGroupProtectedBranch(ctx context.Context, client *gitlab.Client, group, branch string) (*gitlab.ProtectedBranch, error) {
+	// NOTE: go-gitlab doesn't support group protected branches yet.
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/protected_branches/%s", gitlab.PathEscape(group), gitlab.PathEscape(branch)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	pb := new(gitlab.ProtectedBranch)
+	if _, err := client.Do(req, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
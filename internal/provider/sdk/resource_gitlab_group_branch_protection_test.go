@@ -0,0 +1,153 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabGroupBranchProtection_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "15.9")
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupBranchProtectionDestroy,
+		Steps: []resource.TestStep{
+			// Protect `main` and all `release/*` branches
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_branch_protection" "main" {
+						group              = "%[1]d"
+						branch             = "main"
+						push_access_level  = "maintainer"
+						merge_access_level = "developer"
+					}
+
+					resource "gitlab_group_branch_protection" "release" {
+						group              = "%[1]d"
+						branch             = "release/*"
+						push_access_level  = "no one"
+						merge_access_level = "maintainer"
+						allow_force_push   = false
+
+						allowed_to_merge {
+							group_id = %[1]d
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_branch_protection.main", "push_access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_group_branch_protection.main", "merge_access_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_group_branch_protection.release", "branch", "release/*"),
+					resource.TestCheckResourceAttr("gitlab_group_branch_protection.release", "push_access_level", "no one"),
+					resource.TestCheckResourceAttr("gitlab_group_branch_protection.release", "allowed_to_merge.#", "1"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_branch_protection.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "gitlab_group_branch_protection.release",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update code owner approval in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_branch_protection" "main" {
+						group                        = "%[1]d"
+						branch                       = "main"
+						push_access_level            = "maintainer"
+						merge_access_level           = "developer"
+						code_owner_approval_required = true
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_branch_protection.main", "code_owner_approval_required", "true"),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_branch_protection.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupBranchProtection_invalidBranchName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_group_branch_protection" "this" {
+						group  = "foo"
+						branch = "release//*"
+					}
+				`,
+				ExpectError: regexp.MustCompile("must not contain `..`, `//` or `@{`"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupBranchProtection_userIDNotSupported(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_group_branch_protection" "this" {
+						group  = "foo"
+						branch = "main"
+
+						allowed_to_push {
+							user_id = 1
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`user_id` is not supported in `allowed_to_push`"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupBranchProtectionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_branch_protection" {
+			continue
+		}
+
+		group, branch, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getGroupProtectedBranch(context.Background(), testutil.TestGitlabClient, group, branch)
+		if err == nil {
+			return fmt.Errorf("group protected branch %q in group %q still exists", branch, group)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,334 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_epic_board", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_epic_board`" + ` resource allows to manage the lifecycle of a Group Epic Board.
+
+-> Changes to the board lists are applied in-place: lists which are not declared anymore are deleted,
+   new lists are created and existing lists are moved to their new position, so that they keep their IDs.
+
+-> This resource requires a GitLab Enterprise instance with a Premium license.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationepicboardcreate)`,
+
+		CreateContext: resourceGitlabGroupEpicBoardCreate,
+		ReadContext:   resourceGitlabGroupEpicBoardRead,
+		UpdateContext: resourceGitlabGroupEpicBoardUpdate,
+		DeleteContext: resourceGitlabGroupEpicBoardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the board.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"labels": {
+				Description: "The list of label names which the board should be scoped to.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"hide_backlog_list": {
+				Description: "Hide the Open list of the board.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"hide_closed_list": {
+				Description: "Hide the Closed list of the board.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"lists": gitlabEpicBoardListsSchema(),
+		},
+	}
+})
+
+type groupEpicBoard struct {
+	ID              int                    `json:"id"`
+	Name            string                 `json:"name"`
+	HideBacklogList bool                   `json:"hide_backlog_list"`
+	HideClosedList  bool                   `json:"hide_closed_list"`
+	Labels          []*gitlab.LabelDetails `json:"labels"`
+	Lists           []*gitlab.BoardList    `json:"lists"`
+}
+
+type groupEpicBoardMutationPayload struct {
+	EpicBoard *struct {
+		ID string `json:"id"`
+	} `json:"epicBoard"`
+	List *struct {
+		ID string `json:"id"`
+	} `json:"list"`
+	Errors []string `json:"errors"`
+}
+
+type groupEpicBoardMutationResponse struct {
+	Data   map[string]groupEpicBoardMutationPayload `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func resourceGitlabGroupEpicBoardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	g, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get group %q: %s", group, err)
+	}
+
+	log.Printf("[DEBUG] create Group Epic Board %q in group %q", d.Get("name").(string), group)
+	response, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "epicBoardCreate", fmt.Sprintf(`groupPath: %q, %s`, g.FullPath, resourceGitlabGroupEpicBoardAttributes(d)), "epicBoard { id }")
+	if err != nil {
+		return diag.Errorf("failed to create Group Epic Board in group %q: %s", group, err)
+	}
+	if response.EpicBoard == nil {
+		return diag.Errorf("failed to create Group Epic Board in group %q: no epic board returned", group)
+	}
+	epicBoardID, err := extractIIDFromGlobalID(response.EpicBoard.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceGitlabGroupEpicBoardBuildID(group, epicBoardID))
+
+	if v, ok := d.GetOk("lists"); ok {
+		log.Printf("[DEBUG] creating lists for Group Epic Board %d in group %q", epicBoardID, group)
+		if err = updateBoardLists(nil, v.([]interface{}), resourceGitlabGroupEpicBoardListOperations(ctx, client, epicBoardID)); err != nil {
+			return diag.Errorf("failed to create lists for Group Epic Board %d in group %q: %s", epicBoardID, group, err)
+		}
+	}
+
+	return resourceGitlabGroupEpicBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupEpicBoardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read Group Epic Board in group %q with id %d", group, epicBoardID)
+	epicBoard, err := resourceGitlabGroupEpicBoardGet(ctx, client, group, epicBoardID)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] Group Epic Board in group %s with id %d not found, removing from state", group, epicBoardID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	stateMap := map[string]interface{}{
+		"group":             group,
+		"name":              epicBoard.Name,
+		"labels":            extractLabelNames(epicBoard.Labels),
+		"hide_backlog_list": epicBoard.HideBacklogList,
+		"hide_closed_list":  epicBoard.HideClosedList,
+		"lists":             resourceGitlabGroupEpicBoardFlattenLists(epicBoard.Lists),
+	}
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupEpicBoardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "labels", "hide_backlog_list", "hide_closed_list") {
+		log.Printf("[DEBUG] update Group Epic Board %d in group %q", epicBoardID, group)
+		if _, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "epicBoardUpdate", fmt.Sprintf(`boardId: "gid://gitlab/Boards::EpicBoard/%d", %s`, epicBoardID, resourceGitlabGroupEpicBoardAttributes(d)), ""); err != nil {
+			return diag.Errorf("failed to update Group Epic Board %d in group %q: %s", epicBoardID, group, err)
+		}
+	}
+
+	if d.HasChange("lists") {
+		epicBoard, err := resourceGitlabGroupEpicBoardGet(ctx, client, group, epicBoardID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] updating lists for Group Epic Board %d in group %q", epicBoardID, group)
+		if err = updateBoardLists(resourceGitlabGroupEpicBoardLabelLists(epicBoard.Lists), d.Get("lists").([]interface{}), resourceGitlabGroupEpicBoardListOperations(ctx, client, epicBoardID)); err != nil {
+			return diag.Errorf("failed to update lists for Group Epic Board %d in group %q: %s", epicBoardID, group, err)
+		}
+	}
+
+	return resourceGitlabGroupEpicBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupEpicBoardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete Group Epic Board in group %q with id %d", group, epicBoardID)
+	if _, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "destroyEpicBoard", fmt.Sprintf(`id: "gid://gitlab/Boards::EpicBoard/%d"`, epicBoardID), ""); err != nil {
+		return diag.Errorf("failed to delete Group Epic Board %d in group %q: %s", epicBoardID, group, err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupEpicBoardBuildID(group string, epicBoardID int) string {
+	return fmt.Sprintf("%s:%d", group, epicBoardID)
+}
+
+func resourceGitlabGroupEpicBoardParseID(id string) (string, int, error) {
+	group, rawEpicBoardID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	epicBoardID, err := strconv.Atoi(rawEpicBoardID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return group, epicBoardID, nil
+}
+
+// resourceGitlabGroupEpicBoardAttributes returns the GraphQL input arguments for the attributes of the epic board.
+func resourceGitlabGroupEpicBoardAttributes(d *schema.ResourceData) string {
+	labels := *stringSetToStringSlice(d.Get("labels").(*schema.Set))
+	quotedLabels := make([]string, 0, len(labels))
+	for _, label := range labels {
+		quotedLabels = append(quotedLabels, strconv.Quote(label))
+	}
+
+	return fmt.Sprintf(`name: %q, labels: [%s], hideBacklogList: %t, hideClosedList: %t`,
+		d.Get("name").(string), strings.Join(quotedLabels, ", "), d.Get("hide_backlog_list").(bool), d.Get("hide_closed_list").(bool))
+}
+
+// resourceGitlabGroupEpicBoardGet gets the group epic board.
+//
+// NOTE: go-gitlab doesn't support group epic boards yet.
+func resourceGitlabGroupEpicBoardGet(ctx context.Context, client *gitlab.Client, group string, epicBoardID int) (*groupEpicBoard, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/epic_boards/%d", gitlab.PathEscape(group), epicBoardID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	epicBoard := new(groupEpicBoard)
+	if _, err := client.Do(req, epicBoard); err != nil {
+		return nil, err
+	}
+	return epicBoard, nil
+}
+
+// resourceGitlabGroupEpicBoardMutate executes the GraphQL mutation with the given input arguments and
+// returns its payload. The epic boards can only be changed with the GraphQL API.
+func resourceGitlabGroupEpicBoardMutate(ctx context.Context, client *gitlab.Client, mutation string, input string, fields string) (*groupEpicBoardMutationPayload, error) {
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			mutation {
+				%s(
+					input: {
+						%s
+					}
+				) {
+					%s
+					errors
+				}
+			}`, mutation, input, fields),
+	}
+	log.Printf("[DEBUG] executing GraphQL Query %s", query.Query)
+
+	var response groupEpicBoardMutationResponse
+	if _, err := api.SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return nil, fmt.Errorf("%s", strings.Join(messages, ", "))
+	}
+	payload, ok := response.Data[mutation]
+	if !ok {
+		return nil, fmt.Errorf("no result for GraphQL mutation %s", mutation)
+	}
+	if len(payload.Errors) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(payload.Errors, ", "))
+	}
+	return &payload, nil
+}
+
+// resourceGitlabGroupEpicBoardLabelLists returns the label lists of the epic board, without the Open and Closed lists.
+func resourceGitlabGroupEpicBoardLabelLists(lists []*gitlab.BoardList) []*gitlab.BoardList {
+	var labelLists []*gitlab.BoardList
+	for _, list := range lists {
+		if list.Label != nil {
+			labelLists = append(labelLists, list)
+		}
+	}
+	return labelLists
+}
+
+func resourceGitlabGroupEpicBoardFlattenLists(lists []*gitlab.BoardList) (values []map[string]interface{}) {
+	for _, list := range flattenBoardLists(resourceGitlabGroupEpicBoardLabelLists(lists)) {
+		values = append(values, map[string]interface{}{
+			"id":       list["id"],
+			"label_id": list["label_id"],
+			"position": list["position"],
+		})
+	}
+	return values
+}
+
+func resourceGitlabGroupEpicBoardListOperations(ctx context.Context, client *gitlab.Client, epicBoardID int) boardListOperations {
+	return boardListOperations{
+		create: func(scope boardListScope) (int, error) {
+			response, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "epicBoardListCreate", fmt.Sprintf(`boardId: "gid://gitlab/Boards::EpicBoard/%d", labelId: "gid://gitlab/GroupLabel/%d"`, epicBoardID, scope.LabelID), "list { id }")
+			if err != nil {
+				return 0, err
+			}
+			if response.List == nil {
+				return 0, fmt.Errorf("no list returned")
+			}
+			return extractIIDFromGlobalID(response.List.ID)
+		},
+		move: func(listID int, position int) error {
+			_, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "updateEpicBoardList", fmt.Sprintf(`listId: "gid://gitlab/Boards::EpicList/%d", position: %d`, listID, position), "")
+			return err
+		},
+		delete: func(listID int) error {
+			_, err := resourceGitlabGroupEpicBoardMutate(ctx, client, "epicBoardListDestroy", fmt.Sprintf(`listId: "gid://gitlab/Boards::EpicList/%d"`, listID), "")
+			return err
+		},
+	}
+}
@@ -0,0 +1,120 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupEpicBoard_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testLabels := testutil.CreateGroupLabels(t, testGroup.ID, 3)
+
+	listIDs := map[string]string{}
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupEpicBoardDestroy,
+		Steps: []resource.TestStep{
+			// Create Board with 3 lists
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_board" "this" {
+						group  = "%d"
+						name   = "Test Epic Board"
+						labels = ["%s"]
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+					}
+				`, testGroup.ID, testLabels[0].Name, testLabels[0].ID, testLabels[1].ID, testLabels[2].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "labels.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.#", "3"),
+					testAccCheckGitlabBoardListIDs("gitlab_group_epic_board.this", listIDs),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_epic_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the board, reorder the lists and remove a list in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_board" "this" {
+						group             = "%d"
+						name              = "Renamed Test Epic Board"
+						hide_backlog_list = true
+						hide_closed_list  = true
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+					}
+				`, testGroup.ID, testLabels[2].ID, testLabels[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "name", "Renamed Test Epic Board"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "labels.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "hide_backlog_list", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.0.label_id", fmt.Sprintf("%d", testLabels[2].ID)),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.1.label_id", fmt.Sprintf("%d", testLabels[0].ID)),
+					testAccCheckGitlabBoardListIDs("gitlab_group_epic_board.this", listIDs),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_epic_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupEpicBoardDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_epic_board" {
+			continue
+		}
+
+		group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceGitlabGroupEpicBoardGet(context.Background(), testutil.TestGitlabClient, group, epicBoardID)
+		if err == nil {
+			return fmt.Errorf("gitlab_group_epic_board resource '%s' still exists", rs.Primary.ID)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package sdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_invitation", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_invitation`" + ` resource allows to invite a user by email to a group, e.g. when the user doesn't have an account yet.
+
+Once the invitation is accepted, the user is a member of the group. The membership can then be managed with the ` + "`gitlab_group_membership`" + ` resource.
+An accepted invitation remains in the state with ` + "`pending = false`" + `, so that the user is not invited again.
+
+-> Destroying this resource revokes the invitation if it's still pending.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/invitations.html)`,
+
+		CreateContext: resourceGitlabInvitationCreate("group", "group_id"),
+		ReadContext:   resourceGitlabInvitationRead("group", "group_id"),
+		UpdateContext: resourceGitlabInvitationUpdate("group", "group_id"),
+		DeleteContext: resourceGitlabInvitationDelete("group"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabInvitationSchema("group", "group_id", api.ValidGroupAccessLevelNames),
+	}
+})
@@ -0,0 +1,96 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabGroupInvitation_basic(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("acctest"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabInvitationDestroy("gitlab_group_invitation"),
+		Steps: []resource.TestStep{
+			// Invite a user by email
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_invitation" "this" {
+						group_id     = "%d"
+						email        = "%s"
+						access_level = "developer"
+					}
+				`, group.ID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_invitation.this", "pending", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_group_invitation.this", "created_by_name"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_invitation.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the access level and expiry
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_invitation" "this" {
+						group_id     = "%d"
+						email        = "%s"
+						access_level = "maintainer"
+						expires_at   = "2099-01-01"
+					}
+				`, group.ID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_invitation.this", "access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_group_invitation.this", "expires_at", "2099-01-01"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_invitation.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabInvitationDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id, email, err := utils.ParseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			listPendingInvitations := testutil.TestGitlabClient.Invites.ListPendingGroupInvitations
+			if resourceType == "gitlab_project_invitation" {
+				listPendingInvitations = testutil.TestGitlabClient.Invites.ListPendingProjectInvitations
+			}
+			invitations, _, err := listPendingInvitations(id, &gitlab.ListPendingInvitationsOptions{Query: gitlab.String(email)})
+			if err != nil {
+				return err
+			}
+			if len(invitations) > 0 {
+				return fmt.Errorf("invitation of %s to %s is still pending", email, id)
+			}
+		}
+		return nil
+	}
+}
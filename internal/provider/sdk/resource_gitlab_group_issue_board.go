@@ -0,0 +1,242 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_issue_board", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_issue_board`" + ` resource allows to manage the lifecycle of a Group Issue Board.
+
+-> Changes to the board lists are applied in-place: lists which are not declared anymore are deleted,
+   new lists are created and existing lists are moved to their new position, so that they keep their IDs.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_boards.html)`,
+
+		CreateContext: resourceGitlabGroupIssueBoardCreate,
+		ReadContext:   resourceGitlabGroupIssueBoardRead,
+		UpdateContext: resourceGitlabGroupIssueBoardUpdate,
+		DeleteContext: resourceGitlabGroupIssueBoardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: constructSchema(
+			map[string]*schema.Schema{
+				"group": {
+					Description: "The ID or full path of the group.",
+					Type:        schema.TypeString,
+					ForceNew:    true,
+					Required:    true,
+				},
+			},
+			gitlabIssueBoardSchema(),
+		),
+	}
+})
+
+func resourceGitlabGroupIssueBoardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group := d.Get("group").(string)
+	options := gitlab.CreateGroupIssueBoardOptions{
+		Name: gitlab.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] create Group Issue Board %q in group %q", *options.Name, group)
+	issueBoard, _, err := client.GroupIssueBoards.CreateGroupIssueBoard(group, &options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceGitlabGroupIssueBoardBuildID(group, issueBoard.ID))
+
+	updateOptions := gitlab.UpdateGroupIssueBoardOptions{}
+	if v, ok := d.GetOk("milestone_id"); ok {
+		updateOptions.MilestoneID = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("assignee_id"); ok {
+		updateOptions.AssigneeID = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("labels"); ok {
+		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
+		updateOptions.Labels = &gitlabLabels
+	}
+	if v, ok := d.GetOk("weight"); ok {
+		updateOptions.Weight = gitlab.Int(v.(int))
+	}
+
+	if (gitlab.UpdateGroupIssueBoardOptions{}) != updateOptions {
+		log.Printf("[DEBUG] update Group Issue Board %q in group %q after creation", *options.Name, group)
+		_, _, err = client.GroupIssueBoards.UpdateIssueBoard(group, issueBoard.ID, &updateOptions, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("lists"); ok {
+		log.Printf("[DEBUG] creating lists for Group Issue Board %q in group %q", issueBoard.Name, group)
+		if err = updateBoardLists(issueBoard.Lists, v.([]interface{}), resourceGitlabGroupIssueBoardListOperations(ctx, client, group, issueBoard.ID)); err != nil {
+			return diag.Errorf("failed to create lists for Group Issue Board %q in group %q: %s", issueBoard.Name, group, err)
+		}
+	}
+
+	return resourceGitlabGroupIssueBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupIssueBoardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, issueBoardID, err := resourceGitlabGroupIssueBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read Group Issue Board in group %q with id %q", group, issueBoardID)
+	issueBoard, err := resourceGitlabGroupIssueBoardGet(ctx, client, group, issueBoardID)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] Group Issue Board in group %s with id %d not found, removing from state", group, issueBoardID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	stateMap := gitlabIssueBoardToStateMap(issueBoard)
+	stateMap["group"] = group
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupIssueBoardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, issueBoardID, err := resourceGitlabGroupIssueBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.UpdateGroupIssueBoardOptions{}
+	if d.HasChange("name") {
+		options.Name = gitlab.String(d.Get("name").(string))
+	}
+	if d.HasChange("milestone_id") {
+		options.MilestoneID = gitlab.Int(d.Get("milestone_id").(int))
+	}
+	if d.HasChange("assignee_id") {
+		options.AssigneeID = gitlab.Int(d.Get("assignee_id").(int))
+	}
+	if d.HasChange("labels") {
+		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+		options.Labels = &gitlabLabels
+	}
+	if d.HasChange("weight") {
+		options.Weight = gitlab.Int(d.Get("weight").(int))
+	}
+
+	log.Printf("[DEBUG] update Group Issue Board %q in group %q", issueBoardID, group)
+	updatedIssueBoard, _, err := client.GroupIssueBoards.UpdateIssueBoard(group, issueBoardID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("lists") {
+		log.Printf("[DEBUG] updating lists for Group Issue Board %q in group %q", updatedIssueBoard.Name, group)
+		if err = updateBoardLists(updatedIssueBoard.Lists, d.Get("lists").([]interface{}), resourceGitlabGroupIssueBoardListOperations(ctx, client, group, issueBoardID)); err != nil {
+			return diag.Errorf("failed to update lists for Group Issue Board %q in group %q: %s", updatedIssueBoard.Name, group, err)
+		}
+	}
+
+	return resourceGitlabGroupIssueBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupIssueBoardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, issueBoardID, err := resourceGitlabGroupIssueBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete Group Issue Board in group %q with id %q", group, issueBoardID)
+	if _, err := client.GroupIssueBoards.DeleteIssueBoard(group, issueBoardID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupIssueBoardBuildID(group string, issueBoardID int) string {
+	return fmt.Sprintf("%s:%d", group, issueBoardID)
+}
+
+func resourceGitlabGroupIssueBoardParseID(id string) (string, int, error) {
+	group, rawIssueBoardID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	issueBoardID, err := strconv.Atoi(rawIssueBoardID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return group, issueBoardID, nil
+}
+
+// resourceGitlabGroupIssueBoardGet gets the group issue board including its assignee, labels and weight.
+//
+// NOTE: go-gitlab's `GroupIssueBoard` doesn't support the assignee, labels and weight of the board yet.
+func resourceGitlabGroupIssueBoardGet(ctx context.Context, client *gitlab.Client, group string, issueBoardID int) (*gitlab.IssueBoard, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/boards/%d", gitlab.PathEscape(group), issueBoardID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	issueBoard := new(gitlab.IssueBoard)
+	if _, err := client.Do(req, issueBoard); err != nil {
+		return nil, err
+	}
+	return issueBoard, nil
+}
+
+func resourceGitlabGroupIssueBoardListOperations(ctx context.Context, client *gitlab.Client, group string, issueBoardID int) boardListOperations {
+	return boardListOperations{
+		create: func(scope boardListScope) (int, error) {
+			// NOTE: go-gitlab doesn't support creating assignee, milestone and iteration lists in group issue boards yet.
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/boards/%d/lists", gitlab.PathEscape(group), issueBoardID), scope.createIssueBoardListOptions(), []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+			if err != nil {
+				return 0, err
+			}
+			list := new(gitlab.BoardList)
+			if _, err := client.Do(req, list); err != nil {
+				return 0, err
+			}
+			return list.ID, nil
+		},
+		move: func(listID int, position int) error {
+			// NOTE: go-gitlab's `UpdateIssueBoardList` of group issue boards expects a list of board lists in the response,
+			//       but GitLab responds with a single board list.
+			req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%s/boards/%d/lists/%d", gitlab.PathEscape(group), issueBoardID, listID), &gitlab.UpdateGroupIssueBoardListOptions{Position: gitlab.Int(position)}, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+			if err != nil {
+				return err
+			}
+			_, err = client.Do(req, nil)
+			return err
+		},
+		delete: func(listID int) error {
+			_, err := client.GroupIssueBoards.DeleteGroupIssueBoardList(group, issueBoardID, listID, gitlab.WithContext(ctx))
+			return err
+		},
+	}
+}
@@ -65,6 +65,7 @@ func gitlabGroupLabelSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"priority": gitlabLabelPrioritySchema(),
 	}
 }
 
@@ -102,8 +103,9 @@ func resourceGitlabGroupLabelCreate(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*gitlab.Client)
 	group := d.Get("group").(string)
 	options := &gitlab.CreateGroupLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
-		Color: gitlab.String(d.Get("color").(string)),
+		Name:     gitlab.String(d.Get("name").(string)),
+		Color:    gitlab.String(d.Get("color").(string)),
+		Priority: labelPriorityToOption(d.Get("priority").(int)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -145,6 +147,7 @@ func resourceGitlabGroupLabelRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("description", label.Description)
 	d.Set("color", label.Color)
 	d.Set("name", label.Name)
+	d.Set("priority", label.Priority)
 	return nil
 }
 
@@ -155,9 +158,10 @@ func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Failed to parse group label id %q: %s", d.Id(), err)
 	}
 
-	options := &gitlab.UpdateGroupLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
-		Color: gitlab.String(d.Get("color").(string)),
+	options := &updateLabelOptions{
+		Name:     gitlab.String(d.Get("name").(string)),
+		Color:    gitlab.String(d.Get("color").(string)),
+		Priority: labelPriorityToOption(d.Get("priority").(int)),
 	}
 
 	if d.HasChange("description") {
@@ -166,8 +170,8 @@ func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] update gitlab group label %s", d.Id())
 
-	_, _, err = client.GroupLabels.UpdateGroupLabel(group, options, gitlab.WithContext(ctx))
-	if err != nil {
+	// NOTE: go-gitlab doesn't support removing the priority of a label yet.
+	if err := updateLabel(ctx, client, "groups", group, options); err != nil {
 		return diag.FromErr(err)
 	}
 
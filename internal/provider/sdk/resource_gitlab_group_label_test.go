@@ -126,6 +126,7 @@ func TestAccGitlabGroupLabel_basic(t *testing.T) {
 						Color:       "#ff0000",
 						Description: "red label",
 					}),
+					resource.TestCheckResourceAttr("gitlab_group_label.fixme", "priority", "3"),
 				),
 			},
 			{
@@ -137,6 +138,7 @@ func TestAccGitlabGroupLabel_basic(t *testing.T) {
 						Color:       "#ffcc00",
 						Description: "fix this test",
 					}),
+					resource.TestCheckResourceAttr("gitlab_group_label.fixme", "priority", "0"),
 				),
 			},
 			{
@@ -245,6 +247,7 @@ resource "gitlab_group_label" "fixme" {
   name        = "FIXME-%d"
   color       = "#ff0000"
   description = "red label"
+  priority    = 3
 }
 	`, rInt, rInt, rInt)
 }
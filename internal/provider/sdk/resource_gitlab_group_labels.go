@@ -0,0 +1,144 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_labels", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_labels`" + ` resource allows to authoritatively manage all labels of a group.
+
+Labels which are not declared, e.g. because they were added in the UI, are detected and deleted on the next apply.
+Labels inherited from ancestor groups are not managed by this resource.
+
+~> This resource must not be used together with the ` + "`gitlab_group_label`" + ` resource for the same group, because they will fight over the labels.
+
+-> Changed labels are updated in-place, so that their issue, merge request and epic associations are kept.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_labels.html)`,
+
+		CreateContext: resourceGitlabGroupLabelsSet,
+		ReadContext:   resourceGitlabGroupLabelsRead,
+		UpdateContext: resourceGitlabGroupLabelsSet,
+		DeleteContext: resourceGitlabGroupLabelsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"labels": gitlabLabelSetSchema(),
+		},
+	}
+})
+
+func resourceGitlabGroupLabelsSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	current, err := listGroupLabels(ctx, client, group, false, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired, err := expandGitlabLabelSet(d.Get("labels").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing := map[string]bool{}
+	for _, label := range current {
+		want, ok := desired[label.Name]
+		if !ok {
+			log.Printf("[DEBUG] Delete GitLab label %q of group %q", label.Name, group)
+			if _, err := client.GroupLabels.DeleteGroupLabel(group, &gitlab.DeleteGroupLabelOptions{Name: gitlab.String(label.Name)}, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+				return diag.FromErr(err)
+			}
+			continue
+		}
+
+		existing[label.Name] = true
+		if want.Color == label.Color && want.Description == label.Description && want.Priority == label.Priority {
+			continue
+		}
+
+		log.Printf("[DEBUG] Update GitLab label %q of group %q", label.Name, group)
+		// NOTE: go-gitlab doesn't support removing the priority of a label yet.
+		if err := updateLabel(ctx, client, "groups", group, &updateLabelOptions{
+			Name:        gitlab.String(want.Name),
+			Color:       gitlab.String(want.Color),
+			Description: gitlab.String(want.Description),
+			Priority:    labelPriorityToOption(want.Priority),
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for name, label := range desired {
+		if existing[name] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Create GitLab label %q in group %q", name, group)
+		if _, _, err := client.GroupLabels.CreateGroupLabel(group, &gitlab.CreateGroupLabelOptions{
+			Name:        gitlab.String(label.Name),
+			Color:       gitlab.String(label.Color),
+			Description: gitlab.String(label.Description),
+			Priority:    labelPriorityToOption(label.Priority),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(group)
+	return resourceGitlabGroupLabelsRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] Read GitLab labels of group %q", group)
+	labels, err := listGroupLabels(ctx, client, group, false, "")
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] GitLab group %s not found, removing labels from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	if err := d.Set("labels", flattenGitlabLabelSet(labels)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupLabelsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	for _, v := range d.Get("labels").(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+
+		log.Printf("[DEBUG] Delete GitLab label %q of group %q", name, group)
+		if _, err := client.GroupLabels.DeleteGroupLabel(group, &gitlab.DeleteGroupLabelOptions{Name: gitlab.String(name)}, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupLabels_basic(t *testing.T) {
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupLabelsDestroy,
+		Steps: []resource.TestStep{
+			// Create the group labels
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_labels" "this" {
+						group = %d
+
+						labels {
+							name     = "bug"
+							color    = "#ff0000"
+							priority = 1
+						}
+						labels {
+							name        = "feature"
+							color       = "#00ff00"
+							description = "A new feature"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_labels.this", "labels.#", "2"),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_labels.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change a label, remove a label and remove a label added outside of Terraform
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.GroupLabels.CreateGroupLabel(testGroup.ID, &gitlab.CreateGroupLabelOptions{
+						Name:  gitlab.String("unmanaged"),
+						Color: gitlab.String("#000000"),
+					}); err != nil {
+						t.Fatalf("failed to create unmanaged label: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_labels" "this" {
+						group = %d
+
+						labels {
+							name        = "bug"
+							color       = "#cc0000"
+							description = "Something is broken"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_labels.this", "labels.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_group_labels.this", "labels.*", map[string]string{
+						"name":        "bug",
+						"color":       "#cc0000",
+						"description": "Something is broken",
+						"priority":    "0",
+					}),
+				),
+			},
+			// Verify the data source
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_labels" "this" {
+						group = %d
+
+						labels {
+							name        = "bug"
+							color       = "#cc0000"
+							description = "Something is broken"
+						}
+					}
+
+					data "gitlab_group_labels" "this" {
+						group  = gitlab_group_labels.this.group
+						search = "bug"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_labels.this", "labels.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_labels.this", "labels.0.name", "bug"),
+					resource.TestCheckResourceAttr("data.gitlab_group_labels.this", "labels.0.color", "#cc0000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupLabelsDestroy(s *terraform.State) error {
+	for _, resourceState := range s.RootModule().Resources {
+		if resourceState.Type != "gitlab_group_labels" {
+			continue
+		}
+
+		labels, _, err := testutil.TestGitlabClient.GroupLabels.ListGroupLabels(resourceState.Primary.ID, &gitlab.ListGroupLabelsOptions{OnlyGroupLabels: gitlab.Bool(true)})
+		if err != nil {
+			return err
+		}
+		if len(labels) != 0 {
+			return fmt.Errorf("group labels of group %q still exist", resourceState.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -87,6 +87,12 @@ func gitlabGroupLDAPLinkSchema() map[string]*schema.Schema {
 			Required:    true,
 			ForceNew:    true,
 		},
+		"member_role_id": {
+			Description: "The ID of a custom member role for members of the LDAP group, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			ForceNew:    true,
+		},
 		"force": {
 			Description: "If true, then delete and replace an existing LDAP link if one exists.",
 			Type:        schema.TypeBool,
@@ -171,9 +177,14 @@ func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceDa
 	ldap_provider := d.Get("ldap_provider").(string)
 	force := d.Get("force").(bool)
 
-	options := &gitlab.AddGroupLDAPLinkOptions{
-		GroupAccess: &groupAccess,
-		Provider:    &ldap_provider,
+	options := &addGroupLDAPLinkOptions{
+		AddGroupLDAPLinkOptions: gitlab.AddGroupLDAPLinkOptions{
+			GroupAccess: &groupAccess,
+			Provider:    &ldap_provider,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	if cn != "" {
 		options.CN = &cn
@@ -189,7 +200,7 @@ func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[DEBUG] Create GitLab group LdapLink %s", d.Id())
-	ldapLink, _, err := client.Groups.AddGroupLDAPLink(group, options, gitlab.WithContext(ctx))
+	ldapLink, err := addGroupLDAPLink(ctx, client, group, options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -207,12 +218,9 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 
 	// Try to fetch all group links from GitLab
 	log.Printf("[DEBUG] Read GitLab group LdapLinks %s", group)
-	ldapLinks, _, err := client.Groups.ListGroupLDAPLinks(group, nil, gitlab.WithContext(ctx))
+	ldapLinks, err := listGroupLDAPLinks(ctx, client, group)
 	if err != nil {
-		// NOTE: the LDAP list API returns a 404 if there are no LDAP links present.
-		if !api.Is404(err) {
-			return diag.FromErr(err)
-		}
+		return diag.FromErr(err)
 	}
 
 	found := false
@@ -227,6 +235,7 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 			d.Set("group_access", api.AccessLevelValueToName[ldapLink.GroupAccess])
 			d.Set("ldap_provider", ldapLink.Provider)
 			d.Set("filter", ldapLink.Filter)
+			d.Set("member_role_id", memberRoleIDToState(ldapLink.MemberRoleID))
 			found = true
 			break
 		}
@@ -0,0 +1,163 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_ldap_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_ldap_links`" + ` resource allows to authoritatively manage all LDAP group links of a group.
+
+LDAP group links which are not declared, e.g. because they were added in the UI, are detected and removed on the next apply.
+
+~> This resource must not be used together with the ` + "`gitlab_group_ldap_link`" + ` resource for the same group, because they will fight over the links.
+
+-> The LDAP group links can't be updated, therefore a changed link is removed and added again.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#ldap-group-links)`,
+
+		CreateContext: resourceGitlabGroupLdapLinksSet,
+		ReadContext:   resourceGitlabGroupLdapLinksRead,
+		UpdateContext: resourceGitlabGroupLdapLinksSet,
+		DeleteContext: resourceGitlabGroupLdapLinksDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ldap_links": gitlabGroupLDAPLinkSetSchema(false),
+		},
+	}
+})
+
+func resourceGitlabGroupLdapLinksSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	current, err := listGroupLDAPLinks(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := map[string]*ldapGroupLink{}
+	for _, v := range d.Get("ldap_links").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		link := &ldapGroupLink{
+			Provider:    m["ldap_provider"].(string),
+			CN:          m["cn"].(string),
+			Filter:      m["filter"].(string),
+			GroupAccess: api.AccessLevelNameToValue[m["group_access"].(string)],
+		}
+		if (link.CN == "") == (link.Filter == "") {
+			return diag.Errorf("exactly one of `cn` or `filter` must be set for each LDAP link of provider %q", link.Provider)
+		}
+		if memberRoleID := m["member_role_id"].(int); memberRoleID != 0 {
+			link.MemberRoleID = gitlab.Int(memberRoleID)
+		}
+		key := resourceGitLabGroupLDAPLinkBuildId(group, link.Provider, link.CN, link.Filter)
+		if _, ok := desired[key]; ok {
+			return diag.Errorf("LDAP link of provider %q with cn %q and filter %q is declared more than once", link.Provider, link.CN, link.Filter)
+		}
+		desired[key] = link
+	}
+
+	existing := map[string]bool{}
+	for _, link := range current {
+		key := resourceGitLabGroupLDAPLinkBuildId(group, link.Provider, link.CN, link.Filter)
+		if desiredLink, ok := desired[key]; ok && desiredLink.GroupAccess == link.GroupAccess && memberRoleIDToState(desiredLink.MemberRoleID) == memberRoleIDToState(link.MemberRoleID) {
+			existing[key] = true
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete GitLab group LdapLink %s", key)
+		if err := deleteGroupLDAPLink(ctx, client, group, link); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	for key, link := range desired {
+		if existing[key] {
+			continue
+		}
+
+		options := &addGroupLDAPLinkOptions{
+			AddGroupLDAPLinkOptions: gitlab.AddGroupLDAPLinkOptions{
+				GroupAccess: gitlab.AccessLevel(link.GroupAccess),
+				Provider:    gitlab.String(link.Provider),
+			},
+			MemberRoleID: link.MemberRoleID,
+		}
+		if link.CN != "" {
+			options.CN = gitlab.String(link.CN)
+		}
+		if link.Filter != "" {
+			options.Filter = gitlab.String(link.Filter)
+		}
+		log.Printf("[DEBUG] Create GitLab group LdapLink %s", key)
+		if _, err := addGroupLDAPLink(ctx, client, group, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(group)
+	return resourceGitlabGroupLdapLinksRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupLdapLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	if _, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx)); err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] GitLab group %s not found, removing LDAP links from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Read GitLab group LdapLinks %s", group)
+	links, err := listGroupLDAPLinks(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	if err := d.Set("ldap_links", flattenGitlabGroupLDAPLinks(links)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupLdapLinksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	for _, v := range d.Get("ldap_links").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		link := &ldapGroupLink{
+			Provider: m["ldap_provider"].(string),
+			CN:       m["cn"].(string),
+			Filter:   m["filter"].(string),
+		}
+
+		log.Printf("[DEBUG] Delete GitLab group LdapLink %s", resourceGitLabGroupLDAPLinkBuildId(group, link.Provider, link.CN, link.Filter))
+		if err := deleteGroupLDAPLink(ctx, client, group, link); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
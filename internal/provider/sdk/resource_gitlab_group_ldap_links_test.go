@@ -0,0 +1,136 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupLdapLinks_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupLdapLinksDestroy,
+		Steps: []resource.TestStep{
+			// Create the group LDAP links
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_ldap_links" "this" {
+						group = "%d"
+
+						ldap_links {
+							ldap_provider = "default"
+							cn            = "test_cn"
+							group_access  = "developer"
+						}
+						ldap_links {
+							ldap_provider = "default"
+							filter        = "(&(objectClass=person))"
+							group_access  = "reporter"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_ldap_links.this", "ldap_links.#", "2"),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_ldap_links.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change a link and remove a link
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_ldap_links" "this" {
+						group = "%d"
+
+						ldap_links {
+							ldap_provider = "default"
+							cn            = "test_cn"
+							group_access  = "maintainer"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_ldap_links.this", "ldap_links.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_group_ldap_links.this", "ldap_links.*", map[string]string{
+						"cn":           "test_cn",
+						"group_access": "maintainer",
+					}),
+				),
+			},
+			// Verify the data source
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_ldap_links" "this" {
+						group = "%d"
+
+						ldap_links {
+							ldap_provider = "default"
+							cn            = "test_cn"
+							group_access  = "maintainer"
+						}
+					}
+
+					data "gitlab_group_ldap_links" "this" {
+						group = gitlab_group_ldap_links.this.group
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_ldap_links.this", "ldap_links.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_ldap_links.this", "ldap_links.*", map[string]string{
+						"ldap_provider": "default",
+						"cn":            "test_cn",
+						"group_access":  "maintainer",
+					}),
+				),
+			},
+			// Verify that either cn or filter is required
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_ldap_links" "this" {
+						group = "%d"
+
+						ldap_links {
+							ldap_provider = "default"
+							group_access  = "maintainer"
+						}
+					}
+				`, testGroup.ID),
+				ExpectError: regexp.MustCompile("exactly one of `cn` or `filter` must be set"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupLdapLinksDestroy(s *terraform.State) error {
+	for _, resourceState := range s.RootModule().Resources {
+		if resourceState.Type != "gitlab_group_ldap_links" {
+			continue
+		}
+
+		links, _, err := testutil.TestGitlabClient.Groups.ListGroupLDAPLinks(resourceState.Primary.ID)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+		if len(links) > 0 {
+			return fmt.Errorf("group %s still has %d LDAP links", resourceState.Primary.ID, len(links))
+		}
+	}
+	return nil
+}
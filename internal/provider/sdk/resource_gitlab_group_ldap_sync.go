@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_ldap_sync", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_ldap_sync`" + ` resource allows to trigger an LDAP sync of a group.
+
+The sync is triggered when the resource is created and whenever one of the ` + "`triggers`" + ` changes,
+e.g. to sync the group after its LDAP links have been changed. Destroying the resource doesn't change anything in GitLab.
+
+-> This resource requires LDAP to be configured on the GitLab instance and the user to be the owner of the group or an administrator.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#sync-group-with-ldap)`,
+
+		CreateContext: resourceGitlabGroupLdapSyncCreate,
+		ReadContext:   resourceGitlabGroupLdapSyncRead,
+		DeleteContext: resourceGitlabGroupLdapSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will trigger a new LDAP sync.",
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupLdapSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	// NOTE: go-gitlab doesn't support the LDAP sync yet.
+	log.Printf("[DEBUG] Trigger LDAP sync of GitLab group %q", group)
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/ldap_sync", gitlab.PathEscape(group)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.Errorf("failed to trigger LDAP sync of group %q: %v", group, err)
+	}
+
+	d.SetId(group)
+	return resourceGitlabGroupLdapSyncRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupLdapSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	if _, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx)); err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] GitLab group %s not found, removing LDAP sync from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	return nil
+}
+
+func resourceGitlabGroupLdapSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// NOTE: an LDAP sync can't be undone, therefore there is nothing to do.
+	return nil
+}
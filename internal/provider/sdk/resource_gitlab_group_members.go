@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_members`" + ` resource allows to authoritatively manage all members of a group.
+
+Direct members of the group which are not declared, e.g. because they were added in the UI, are detected and removed on the next apply.
+Additions and removals show up as changed elements of the ` + "`members`" + ` set in the plan.
+
+~> This resource must not be used together with the ` + "`gitlab_group_membership`" + ` resource for the same group, because they will fight over the members.
+
+~> Make sure to declare the user used by the provider, otherwise it's removed from the group and may lose access.
+The user used by the provider is never removed when the resource is destroyed.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html)`,
+
+		CreateContext: resourceGitlabGroupMembersSet,
+		ReadContext:   resourceGitlabGroupMembersRead,
+		UpdateContext: resourceGitlabGroupMembersSet,
+		DeleteContext: resourceGitlabGroupMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: constructSchema(
+			map[string]*schema.Schema{
+				"group_id": {
+					Description: "The ID or URL-encoded path of the group.",
+					Type:        schema.TypeString,
+					ForceNew:    true,
+					Required:    true,
+				},
+				"skip_subresources_on_removal": {
+					Description: "Whether the deletion of direct memberships of removed members in subgroups and projects should be skipped.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"unassign_issuables_on_removal": {
+					Description: "Whether removed members should be unassigned from any issues or merge requests inside the group.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+			gitlabMembersSchema("group", api.ValidGroupAccessLevelNames),
+		),
+	}
+})
+
+func resourceGitlabGroupMembersSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	groupID := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] set gitlab group members of %s", groupID)
+	if err := gitlabMembersApply(d, resourceGitlabGroupMembersClient(ctx, d, client, groupID)); err != nil {
+		return diag.Errorf("failed to set members of group %s: %v", groupID, err)
+	}
+
+	d.SetId(groupID)
+	return resourceGitlabGroupMembersRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	groupID := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group members of %s", groupID)
+	members, err := gitlabMembersRead(d, resourceGitlabGroupMembersClient(ctx, d, client, groupID))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found so removing members from state", groupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group_id", groupID)
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	groupID := d.Id()
+
+	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get current user: %v", err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab group members of %s", groupID)
+	if err := gitlabMembersDelete(d, resourceGitlabGroupMembersClient(ctx, d, client, groupID), currentUser.ID); err != nil {
+		return diag.Errorf("failed to delete members of group %s: %v", groupID, err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupMembersClient(ctx context.Context, d *schema.ResourceData, client *gitlab.Client, groupID string) *gitlabMembersClient {
+	list := func(listMembers func(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error)) ([]*gitlabMember, error) {
+		options := &gitlab.ListGroupMembersOptions{
+			ListOptions: gitlab.ListOptions{
+				PerPage: 100,
+				Page:    1,
+			},
+		}
+
+		var members []*gitlabMember
+		for options.Page != 0 {
+			groupMembers, resp, err := listMembers(groupID, options, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range groupMembers {
+				members = append(members, &gitlabMember{
+					UserID:      m.ID,
+					Username:    m.Username,
+					AccessLevel: m.AccessLevel,
+					ExpiresAt:   isoTimeToString(m.ExpiresAt),
+				})
+			}
+			options.Page = resp.NextPage
+		}
+		return members, nil
+	}
+
+	return &gitlabMembersClient{
+		listDirect: func() ([]*gitlabMember, error) {
+			return list(client.Groups.ListGroupMembers)
+		},
+		listAll: func() ([]*gitlabMember, error) {
+			return list(client.Groups.ListAllGroupMembers)
+		},
+		add: func(member *gitlabMember) error {
+			_, _, err := client.GroupMembers.AddGroupMember(groupID, &gitlab.AddGroupMemberOptions{
+				UserID:      gitlab.Int(member.UserID),
+				AccessLevel: gitlab.AccessLevel(member.AccessLevel),
+				ExpiresAt:   gitlab.String(member.ExpiresAt),
+			}, gitlab.WithContext(ctx))
+			return err
+		},
+		edit: func(member *gitlabMember) error {
+			_, _, err := client.GroupMembers.EditGroupMember(groupID, member.UserID, &gitlab.EditGroupMemberOptions{
+				AccessLevel: gitlab.AccessLevel(member.AccessLevel),
+				ExpiresAt:   gitlab.String(member.ExpiresAt),
+			}, gitlab.WithContext(ctx))
+			return err
+		},
+		remove: func(member *gitlabMember) error {
+			_, err := client.GroupMembers.RemoveGroupMember(groupID, member.UserID, &gitlab.RemoveGroupMemberOptions{
+				SkipSubresources:  gitlab.Bool(d.Get("skip_subresources_on_removal").(bool)),
+				UnassignIssuables: gitlab.Bool(d.Get("unassign_issuables_on_removal").(bool)),
+			}, gitlab.WithContext(ctx))
+			return err
+		},
+	}
+}
@@ -0,0 +1,249 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupMembers_basic(t *testing.T) {
+	currentUser := testutil.GetCurrentUser(t)
+	group := testutil.CreateGroups(t, 1)[0]
+	users := testutil.CreateUsers(t, 3)
+	// NOTE: this member is added outside of Terraform and must be removed on apply.
+	testutil.AddGroupMembers(t, group.ID, []*gitlab.User{users[2]})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupMembersDestroy(group.ID, users),
+		Steps: []resource.TestStep{
+			// Declare the members, which removes the unmanaged member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id = "%d"
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "developer"
+						}
+					}
+				`, group.ID, currentUser.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "2"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.DeveloperPermissions,
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_members.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_subresources_on_removal", "unassign_issuables_on_removal", "ignore_inherited_members", "ignore_bot_members"},
+			},
+			// Update an access level and add a member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id = "%d"
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "maintainer"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "reporter"
+							expires_at   = "2099-01-01"
+						}
+					}
+				`, group.ID, currentUser.ID, users[0].ID, users[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "3"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.MaintainerPermissions,
+						users[1].ID:    gitlab.ReporterPermissions,
+					}),
+				),
+			},
+			// Add an unmanaged member again, which is detected as a removal
+			{
+				PreConfig: func() {
+					testutil.AddGroupMembers(t, group.ID, []*gitlab.User{users[2]})
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "4"),
+			},
+			// Remove a member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id = "%d"
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "maintainer"
+						}
+					}
+				`, group.ID, currentUser.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "2"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.MaintainerPermissions,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupMembers_inherited(t *testing.T) {
+	currentUser := testutil.GetCurrentUser(t)
+	parentGroup := testutil.CreateGroups(t, 1)[0]
+	group := testutil.CreateSubGroups(t, parentGroup, 1)[0]
+	users := testutil.CreateUsers(t, 2)
+	testutil.AddGroupMembers(t, parentGroup.ID, []*gitlab.User{users[0]})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			// Inherited members must be declared
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id = "%d"
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "guest"
+						}
+					}
+				`, group.ID, currentUser.ID, users[1].ID),
+				ExpectError: regexp.MustCompile(`is inherited from a parent group and can't be removed`),
+			},
+			// Declare the inherited member with its inherited access level
+			{
+				// The invalid member list must not have been partially applied
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.GroupMembers.GetGroupMember(group.ID, users[1].ID); !api.Is404(err) {
+						t.Fatalf("expected user %d to not be added to the group when the member list is invalid, got: %v", users[1].ID, err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id = "%d"
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "developer"
+						}
+					}
+				`, group.ID, currentUser.ID, users[0].ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "2"),
+			},
+			// Ignore the inherited members
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_members" "this" {
+						group_id                 = "%d"
+						ignore_inherited_members = true
+
+						members {
+							user_id      = %d
+							access_level = "owner"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "guest"
+						}
+					}
+				`, group.ID, currentUser.ID, users[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.this", "members.#", "2"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[1].ID:    gitlab.GuestPermissions,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupMembers(groupID int, expected map[int]gitlab.AccessLevelValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, _, err := testutil.TestGitlabClient.Groups.ListGroupMembers(groupID, &gitlab.ListGroupMembersOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(members) != len(expected) {
+			return fmt.Errorf("expected %d direct members, got %d", len(expected), len(members))
+		}
+		for _, m := range members {
+			accessLevel, ok := expected[m.ID]
+			if !ok {
+				return fmt.Errorf("unexpected member %s (%d)", m.Username, m.ID)
+			}
+			if m.AccessLevel != accessLevel {
+				return fmt.Errorf("expected access level %d for member %d, got %d", accessLevel, m.ID, m.AccessLevel)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupMembersDestroy(groupID int, users []*gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, user := range users {
+			_, _, err := testutil.TestGitlabClient.GroupMembers.GetGroupMember(groupID, user.ID)
+			if err == nil {
+				return fmt.Errorf("user %d is still a member of group %d", user.ID, groupID)
+			}
+		}
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -57,6 +58,11 @@ var _ = registerResource("gitlab_group_membership", func() *schema.Resource {
 				ValidateFunc: validateDateFunc,
 				Optional:     true,
 			},
+			"member_role_id": {
+				Description: "The ID of a custom member role, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 			"skip_subresources_on_destroy": {
 				Description: "Whether the deletion of direct memberships of the removed member in subgroups and projects should be skipped. Only used during a destroy.",
 				Type:        schema.TypeBool,
@@ -81,17 +87,27 @@ func resourceGitlabGroupMembershipCreate(ctx context.Context, d *schema.Resource
 	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := api.AccessLevelNameToValue[d.Get("access_level").(string)]
 
-	options := &gitlab.AddGroupMemberOptions{
-		UserID:      &userId,
-		AccessLevel: &accessLevelId,
-		ExpiresAt:   &expiresAt,
+	options := &addGroupMemberOptions{
+		AddGroupMemberOptions: gitlab.AddGroupMemberOptions{
+			UserID:      &userId,
+			AccessLevel: &accessLevelId,
+			ExpiresAt:   &expiresAt,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	log.Printf("[DEBUG] create gitlab group groupMember for %d in %s", options.UserID, groupId)
 
-	groupMember, _, err := client.GroupMembers.AddGroupMember(groupId, options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the `member_role_id` attribute yet.
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/members", gitlab.PathEscape(groupId)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var groupMember groupMemberWithRole
+	if _, err := client.Do(req, &groupMember); err != nil {
+		return diag.FromErr(err)
+	}
 	userIdString := strconv.Itoa(groupMember.ID)
 	d.SetId(utils.BuildTwoPartID(&groupId, &userIdString))
 	return resourceGitlabGroupMembershipRead(ctx, d, meta)
@@ -107,8 +123,12 @@ func resourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	groupMember, _, err := client.GroupMembers.GetGroupMember(groupId, userId, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/members/%d", gitlab.PathEscape(groupId), userId), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
+		return diag.FromErr(err)
+	}
+	var groupMember groupMemberWithRole
+	if _, err := client.Do(req, &groupMember); err != nil {
 		if api.Is404(err) {
 			log.Printf("[DEBUG] gitlab group membership for %s not found so removing from state", d.Id())
 			d.SetId("")
@@ -117,7 +137,7 @@ func resourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	resourceGitlabGroupMembershipSetToState(d, groupMember, &groupId)
+	resourceGitlabGroupMembershipSetToState(d, &groupMember, &groupId)
 	return nil
 }
 
@@ -141,16 +161,24 @@ func resourceGitlabGroupMembershipUpdate(ctx context.Context, d *schema.Resource
 	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := api.AccessLevelNameToValue[strings.ToLower(d.Get("access_level").(string))]
 
-	options := gitlab.EditGroupMemberOptions{
-		AccessLevel: &accessLevelId,
-		ExpiresAt:   &expiresAt,
+	options := editGroupMemberOptions{
+		EditGroupMemberOptions: gitlab.EditGroupMemberOptions{
+			AccessLevel: &accessLevelId,
+			ExpiresAt:   &expiresAt,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	log.Printf("[DEBUG] update gitlab group membership %v for %s", userId, groupId)
 
-	_, _, err := client.GroupMembers.EditGroupMember(groupId, userId, &options, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%s/members/%d", gitlab.PathEscape(groupId), userId), &options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return resourceGitlabGroupMembershipRead(ctx, d, meta)
 }
@@ -179,7 +207,7 @@ func resourceGitlabGroupMembershipDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceGitlabGroupMembershipSetToState(d *schema.ResourceData, groupMember *gitlab.GroupMember, groupId *string) {
+func resourceGitlabGroupMembershipSetToState(d *schema.ResourceData, groupMember *groupMemberWithRole, groupId *string) {
 
 	d.Set("group_id", groupId)
 	d.Set("user_id", groupMember.ID)
@@ -189,6 +217,28 @@ func resourceGitlabGroupMembershipSetToState(d *schema.ResourceData, groupMember
 	} else {
 		d.Set("expires_at", "")
 	}
+	if groupMember.MemberRole != nil {
+		d.Set("member_role_id", groupMember.MemberRole.ID)
+	} else {
+		d.Set("member_role_id", 0)
+	}
 	userId := strconv.Itoa(groupMember.ID)
 	d.SetId(utils.BuildTwoPartID(groupId, &userId))
 }
+
+// groupMemberWithRole is a group member including the custom member role, which is not yet available in go-gitlab.
+type groupMemberWithRole struct {
+	gitlab.GroupMember
+	MemberRole *api.MemberRoleID `json:"member_role"`
+}
+
+type addGroupMemberOptions struct {
+	gitlab.AddGroupMemberOptions
+	MemberRoleID *int `json:"member_role_id,omitempty"`
+}
+
+// editGroupMemberOptions always sends the `member_role_id`, so that removing it from the configuration removes the custom member role.
+type editGroupMemberOptions struct {
+	gitlab.EditGroupMemberOptions
+	MemberRoleID *int `json:"member_role_id"`
+}
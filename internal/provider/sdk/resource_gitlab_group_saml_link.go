@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,14 +15,6 @@ import (
 )
 
 var _ = registerResource("gitlab_group_saml_link", func() *schema.Resource {
-	validGroupSamlLinkAccessLevelNames := []string{
-		"guest",
-		"reporter",
-		"developer",
-		"maintainer",
-		"owner",
-	}
-
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_group_saml_link`" + ` resource allows to manage the lifecycle of an SAML integration with a group.
 
@@ -54,6 +47,12 @@ var _ = registerResource("gitlab_group_saml_link", func() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 			},
+			"member_role_id": {
+				Description: "The ID of a custom member role for members of the SAML group, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
 		},
 	}
 })
@@ -65,13 +64,18 @@ func resourceGitlabGroupSamlLinkCreate(ctx context.Context, d *schema.ResourceDa
 	samlGroupName := d.Get("saml_group_name").(string)
 	accessLevel := api.AccessLevelNameToValue[d.Get("access_level").(string)]
 
-	options := &gitlab.AddGroupSAMLLinkOptions{
-		SAMLGroupName: gitlab.String(samlGroupName),
-		AccessLevel:   gitlab.AccessLevel(accessLevel),
+	options := &addGroupSAMLLinkOptions{
+		AddGroupSAMLLinkOptions: gitlab.AddGroupSAMLLinkOptions{
+			SAMLGroupName: gitlab.String(samlGroupName),
+			AccessLevel:   gitlab.AccessLevel(accessLevel),
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 
 	log.Printf("[DEBUG] Create GitLab Group SAML Link for group %q with name %q", group, samlGroupName)
-	SamlLink, _, err := client.Groups.AddGroupSAMLLink(group, options, gitlab.WithContext(ctx))
+	SamlLink, err := addGroupSAMLLink(ctx, client, group, options)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,8 +93,13 @@ func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData
 
 	// Try to fetch all group links from GitLab
 	log.Printf("[DEBUG] Read GitLab Group SAML Link for group %q", group)
-	samlLink, _, err := client.Groups.GetGroupSAMLLink(group, samlGroupName, nil, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the `member_role_id` attribute yet.
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/saml_group_links/%s", gitlab.PathEscape(group), gitlab.PathEscape(samlGroupName)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
+		return diag.FromErr(err)
+	}
+	var samlLink samlGroupLink
+	if _, err := client.Do(req, &samlLink); err != nil {
 		if api.Is404(err) {
 			log.Printf("[DEBUG] GitLab SAML Group Link %s for group ID %s not found, removing from state", samlGroupName, group)
 			d.SetId("")
@@ -102,6 +111,7 @@ func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData
 	d.Set("group", group)
 	d.Set("access_level", api.AccessLevelValueToName[samlLink.AccessLevel])
 	d.Set("saml_group_name", samlLink.Name)
+	d.Set("member_role_id", memberRoleIDToState(samlLink.MemberRoleID))
 
 	return nil
 }
@@ -0,0 +1,141 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_group_saml_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_saml_links`" + ` resource allows to authoritatively manage all SAML group links of a group.
+
+SAML group links which are not declared, e.g. because they were added in the UI, are detected and removed on the next apply.
+
+~> This resource must not be used together with the ` + "`gitlab_group_saml_link`" + ` resource for the same group, because they will fight over the links.
+
+-> The SAML group links can't be updated, therefore a changed link is removed and added again.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#saml-group-links)`,
+
+		CreateContext: resourceGitlabGroupSamlLinksSet,
+		ReadContext:   resourceGitlabGroupSamlLinksRead,
+		UpdateContext: resourceGitlabGroupSamlLinksSet,
+		DeleteContext: resourceGitlabGroupSamlLinksDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"saml_links": gitlabGroupSAMLLinkSetSchema(false),
+		},
+	}
+})
+
+func resourceGitlabGroupSamlLinksSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	current, err := listGroupSAMLLinks(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := map[string]*samlGroupLink{}
+	for _, v := range d.Get("saml_links").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		link := &samlGroupLink{
+			Name:        m["saml_group_name"].(string),
+			AccessLevel: api.AccessLevelNameToValue[m["access_level"].(string)],
+		}
+		if memberRoleID := m["member_role_id"].(int); memberRoleID != 0 {
+			link.MemberRoleID = gitlab.Int(memberRoleID)
+		}
+		if _, ok := desired[link.Name]; ok {
+			return diag.Errorf("SAML group %q is declared more than once", link.Name)
+		}
+		desired[link.Name] = link
+	}
+
+	existing := map[string]bool{}
+	for _, link := range current {
+		if d, ok := desired[link.Name]; ok && d.AccessLevel == link.AccessLevel && memberRoleIDToState(d.MemberRoleID) == memberRoleIDToState(link.MemberRoleID) {
+			existing[link.Name] = true
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete GitLab Group SAML Link for group %q with name %q", group, link.Name)
+		if _, err := client.Groups.DeleteGroupSAMLLink(group, link.Name, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	for name, link := range desired {
+		if existing[name] {
+			continue
+		}
+
+		options := &addGroupSAMLLinkOptions{
+			AddGroupSAMLLinkOptions: gitlab.AddGroupSAMLLinkOptions{
+				SAMLGroupName: gitlab.String(link.Name),
+				AccessLevel:   gitlab.AccessLevel(link.AccessLevel),
+			},
+			MemberRoleID: link.MemberRoleID,
+		}
+		log.Printf("[DEBUG] Create GitLab Group SAML Link for group %q with name %q", group, name)
+		if _, err := addGroupSAMLLink(ctx, client, group, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(group)
+	return resourceGitlabGroupSamlLinksRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupSamlLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] Read GitLab Group SAML Links for group %q", group)
+	links, err := listGroupSAMLLinks(ctx, client, group)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] GitLab group %s not found, removing SAML links from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	if err := d.Set("saml_links", flattenGitlabGroupSAMLLinks(links)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupSamlLinksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	for _, v := range d.Get("saml_links").(*schema.Set).List() {
+		name := v.(map[string]interface{})["saml_group_name"].(string)
+
+		log.Printf("[DEBUG] Delete GitLab Group SAML Link for group %q with name %q", group, name)
+		if _, err := client.Groups.DeleteGroupSAMLLink(group, name, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupSamlLinks_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "15.3")
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupSamlLinksDestroy,
+		Steps: []resource.TestStep{
+			// Create the group SAML links
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_saml_links" "this" {
+						group = "%d"
+
+						saml_links {
+							saml_group_name = "test_saml_group_1"
+							access_level    = "developer"
+						}
+						saml_links {
+							saml_group_name = "test_saml_group_2"
+							access_level    = "reporter"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_saml_links.this", "saml_links.#", "2"),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_group_saml_links.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change a link, remove a link and remove a link added outside of Terraform
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Groups.AddGroupSAMLLink(testGroup.ID, &gitlab.AddGroupSAMLLinkOptions{
+						SAMLGroupName: gitlab.String("test_saml_group_unmanaged"),
+						AccessLevel:   gitlab.AccessLevel(gitlab.GuestPermissions),
+					}); err != nil {
+						t.Fatalf("failed to add unmanaged SAML group link: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_saml_links" "this" {
+						group = "%d"
+
+						saml_links {
+							saml_group_name = "test_saml_group_1"
+							access_level    = "maintainer"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_saml_links.this", "saml_links.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_group_saml_links.this", "saml_links.*", map[string]string{
+						"saml_group_name": "test_saml_group_1",
+						"access_level":    "maintainer",
+					}),
+				),
+			},
+			// Verify the data source
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_saml_links" "this" {
+						group = "%d"
+
+						saml_links {
+							saml_group_name = "test_saml_group_1"
+							access_level    = "maintainer"
+						}
+					}
+
+					data "gitlab_group_saml_links" "this" {
+						group = gitlab_group_saml_links.this.group
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_saml_links.this", "saml_links.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_saml_links.this", "saml_links.*", map[string]string{
+						"saml_group_name": "test_saml_group_1",
+						"access_level":    "maintainer",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupSamlLinksDestroy(s *terraform.State) error {
+	for _, resourceState := range s.RootModule().Resources {
+		if resourceState.Type != "gitlab_group_saml_links" {
+			continue
+		}
+
+		links, _, err := testutil.TestGitlabClient.Groups.ListGroupSAMLLinks(resourceState.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, link := range links {
+			if link.Name != "test_saml_group_unmanaged" {
+				return fmt.Errorf("SAML group link %q still exists", link.Name)
+			}
+		}
+	}
+	return nil
+}
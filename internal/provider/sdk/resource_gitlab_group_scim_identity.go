@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_scim_identity", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_scim_identity`" + ` resource allows to manage the external UID of the SCIM identity of a user in a top-level group.
+
+The SCIM identity must already exist, i.e. the user must have been provisioned by the identity provider.
+The resource adopts the identity and updates its ` + "`extern_uid`" + `, e.g. to repair a misaligned identity mapping.
+
+-> This resource is only available on GitLab.com for top-level groups with SCIM provisioning enabled.
+
+~> Destroying this resource only removes it from the state. The SCIM identity is not deleted, because that would deprovision the user.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/scim.html)`,
+
+		CreateContext: resourceGitlabGroupScimIdentityCreate,
+		ReadContext:   resourceGitlabGroupScimIdentityRead,
+		UpdateContext: resourceGitlabGroupScimIdentityUpdate,
+		DeleteContext: resourceGitlabGroupScimIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"user_id": {
+				Description: "The id of the GitLab user of the SCIM identity.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"extern_uid": {
+				Description: "The external UID of the user, as provided by the identity provider.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"active": {
+				Description: "Whether the SCIM identity is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupScimIdentityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	userID := d.Get("user_id").(int)
+
+	// NOTE: GitLab responds with a 404 for groups without SCIM provisioning, which don't have any SCIM identity.
+	identity, err := findGroupScimIdentity(ctx, client, group, userID)
+	if err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+	if identity == nil {
+		return diag.Errorf("no SCIM identity found for user %d in group %s", userID, group)
+	}
+
+	userIDForID := strconv.Itoa(userID)
+	d.SetId(utils.BuildTwoPartID(&group, &userIDForID))
+
+	if externUID := d.Get("extern_uid").(string); externUID != identity.ExternUID {
+		if err := resourceGitlabGroupScimIdentityUpdateExternUID(ctx, client, group, identity.ExternUID, externUID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabGroupScimIdentityRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupScimIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group, userID, err := groupIdAndUserIdFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	identity, err := findGroupScimIdentity(ctx, client, group, userID)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing SCIM identity of user %d from state", group, userID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if identity == nil {
+		log.Printf("[DEBUG] SCIM identity of user %d in gitlab group %s not found, removing from state", userID, group)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("user_id", identity.UserID)
+	d.Set("extern_uid", identity.ExternUID)
+	d.Set("active", identity.Active)
+	return nil
+}
+
+func resourceGitlabGroupScimIdentityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group, _, err := groupIdAndUserIdFromId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("extern_uid") {
+		oldExternUID, newExternUID := d.GetChange("extern_uid")
+		if err := resourceGitlabGroupScimIdentityUpdateExternUID(ctx, client, group, oldExternUID.(string), newExternUID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabGroupScimIdentityRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupScimIdentityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// NOTE: deleting the SCIM identity would deprovision the user, therefore it's only removed from the state.
+	log.Printf("[DEBUG] removing SCIM identity %s from state, the identity is kept in GitLab", d.Id())
+	return nil
+}
+
+func resourceGitlabGroupScimIdentityUpdateExternUID(ctx context.Context, client *gitlab.Client, group string, oldExternUID string, newExternUID string) error {
+	log.Printf("[DEBUG] update extern_uid of SCIM identity %s in gitlab group %s to %s", oldExternUID, group, newExternUID)
+	return updateGroupScimIdentity(ctx, client, group, oldExternUID, &updateScimIdentityOptions{
+		ExternUID: gitlab.String(newExternUID),
+	})
+}
+
+func findGroupScimIdentity(ctx context.Context, client *gitlab.Client, group string, userID int) (*scimIdentity, error) {
+	identities, err := listGroupScimIdentities(ctx, client, group)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.UserID == userID {
+			return identity, nil
+		}
+	}
+	return nil, nil
+}
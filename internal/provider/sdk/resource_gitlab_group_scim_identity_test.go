@@ -0,0 +1,39 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+// NOTE: SCIM identities are only available on GitLab.com, therefore the acceptance test
+// can only verify that a missing SCIM identity is reported.
+func TestAccGitlabGroupScimIdentity_notFound(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_scim_identity" "this" {
+						group      = "%d"
+						user_id    = %d
+						extern_uid = "some-extern-uid"
+					}
+				`, testGroup.ID, testUser.ID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`no SCIM identity found for user %d in group %d`, testUser.ID, testGroup.ID)),
+			},
+		},
+	})
+}
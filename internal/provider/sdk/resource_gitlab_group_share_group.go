@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -55,6 +56,12 @@ var _ = registerResource("gitlab_group_share_group", func() *schema.Resource {
 				ForceNew:     true,
 				Optional:     true,
 			},
+			"member_role_id": {
+				Description: "The ID of a custom member role to grant the group, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Optional:    true,
+			},
 		},
 	}
 })
@@ -63,19 +70,28 @@ func resourceGitlabGroupShareGroupCreate(ctx context.Context, d *schema.Resource
 	groupId := d.Get("group_id").(string)
 	shareGroupId := d.Get("share_group_id").(int)
 	groupAccess := api.AccessLevelNameToValue[d.Get("group_access").(string)]
-	options := &gitlab.ShareWithGroupOptions{
-		GroupID:     &shareGroupId,
-		GroupAccess: &groupAccess,
-		ExpiresAt:   gitlab.String(d.Get("expires_at").(string)),
+	options := &shareWithGroupOptions{
+		ShareWithGroupOptions: gitlab.ShareWithGroupOptions{
+			GroupID:     &shareGroupId,
+			GroupAccess: &groupAccess,
+			ExpiresAt:   gitlab.String(d.Get("expires_at").(string)),
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 
 	client := meta.(*gitlab.Client)
 	log.Printf("[DEBUG] create gitlab group share for %d in %s", shareGroupId, groupId)
 
-	_, _, err := client.GroupMembers.ShareWithGroup(groupId, options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the `member_role_id` attribute yet.
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/share", gitlab.PathEscape(groupId)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 
 	shareGroupIdString := strconv.Itoa(shareGroupId)
 	d.SetId(utils.BuildTwoPartID(&groupId, &shareGroupIdString))
@@ -94,8 +110,12 @@ func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// Query main group
-	group, _, err := client.Groups.GetGroup(groupId, nil, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s", gitlab.PathEscape(groupId)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
+		return diag.FromErr(err)
+	}
+	var group sharedWithGroups
+	if _, err := client.Do(req, &group); err != nil {
 		if api.Is404(err) {
 			log.Printf("[DEBUG] gitlab group %s not found so removing from state", groupId)
 			d.SetId("")
@@ -118,6 +138,10 @@ func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceDa
 			} else {
 				d.Set("expires_at", sharedGroup.ExpiresAt.String())
 			}
+			// NOTE: older GitLab versions don't return the custom member role.
+			if sharedGroup.MemberRoleID != nil {
+				d.Set("member_role_id", *sharedGroup.MemberRoleID)
+			}
 
 			return nil
 		}
@@ -160,3 +184,21 @@ func groupIdsFromId(id string) (string, int, error) {
 
 	return groupId, sharedGroupId, nil
 }
+
+// sharedWithGroups are the groups a group or project is shared with, including the custom member role,
+// which is not yet available in go-gitlab.
+type sharedWithGroups struct {
+	SharedWithGroups []sharedWithGroup `json:"shared_with_groups"`
+}
+
+type sharedWithGroup struct {
+	GroupID          int             `json:"group_id"`
+	GroupAccessLevel int             `json:"group_access_level"`
+	ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+	MemberRoleID     *int            `json:"member_role_id"`
+}
+
+type shareWithGroupOptions struct {
+	gitlab.ShareWithGroupOptions
+	MemberRoleID *int `json:"member_role_id,omitempty"`
+}
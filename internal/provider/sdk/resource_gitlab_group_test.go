@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -744,3 +745,90 @@ resource "gitlab_group" "foo" {
 }
   `, rInt, rInt)
 }
+
+func TestAccGitlabGroup_DeletionModePermanent(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "16.0")
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupPermanentlyRemoved,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "this" {
+						name          = "foo-name-%[1]d"
+						path          = "foo-path-%[1]d"
+						deletion_mode = "permanent"
+					}
+				`, rInt),
+				Check: resource.TestCheckResourceAttr("gitlab_group.this", "deletion_mode", "permanent"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroup_RestorePendingDeletion(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "16.0")
+
+	group := testutil.CreateGroups(t, 1)[0]
+	if _, err := testutil.TestGitlabClient.Groups.DeleteGroup(group.ID); err != nil {
+		t.Fatalf("failed to delete group %d: %v", group.ID, err)
+	}
+	pendingDeletion, _, err := testutil.TestGitlabClient.Groups.GetGroup(group.ID, nil)
+	if err != nil || pendingDeletion.MarkedForDeletionOn == nil {
+		t.Skip("delayed group deletion is not enabled")
+	}
+
+	config := func(restore bool) string {
+		return fmt.Sprintf(`
+			resource "gitlab_group" "this" {
+				name                     = "%s"
+				path                     = "%s"
+				description              = "Restored by Terraform"
+				deletion_mode            = "permanent"
+				restore_pending_deletion = %t
+			}
+		`, group.Name, group.Path, restore)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupPermanentlyRemoved,
+		Steps: []resource.TestStep{
+			// Creating a group at the path of a group pending deletion fails
+			{
+				Config:      config(false),
+				ExpectError: regexp.MustCompile(`is pending deletion and blocks its path`),
+			},
+			// Restore the group pending deletion and update it to match the configuration
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.this", "id", fmt.Sprintf("%d", group.ID)),
+					resource.TestCheckResourceAttr("gitlab_group.this", "description", "Restored by Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupPermanentlyRemoved(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group" {
+			continue
+		}
+
+		_, _, err := testutil.TestGitlabClient.Groups.GetGroup(rs.Primary.ID, nil)
+		if err == nil {
+			return fmt.Errorf("group %s still exists", rs.Primary.ID)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
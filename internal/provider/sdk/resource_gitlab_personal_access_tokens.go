@@ -10,7 +10,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
@@ -41,59 +40,7 @@ var _ = registerResource("gitlab_personal_access_token", func() *schema.Resource
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
-			"user_id": {
-				Description: "The id of the user.",
-				Type:        schema.TypeInt,
-				ForceNew:    true,
-				Required:    true,
-			},
-			"name": {
-				Description: "The name of the personal access token.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"scopes": {
-				Description: fmt.Sprintf("The scope for the personal access token. It determines the actions which can be performed when authenticating with this token. Valid values are: %s.", utils.RenderValueListForDocs(validPersonalAccessTokenScopes)),
-				Type:        schema.TypeSet,
-				Required:    true,
-				ForceNew:    true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(validPersonalAccessTokenScopes, false),
-				},
-			},
-			"active": {
-				Description: "True if the token is active.",
-				Type:        schema.TypeBool,
-				Computed:    true,
-			},
-			"revoked": {
-				Description: "True if the token is revoked.",
-				Type:        schema.TypeBool,
-				Computed:    true,
-			},
-			"created_at": {
-				Description: "Time the token has been created, RFC3339 format.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"expires_at": {
-				Description:      "The token expires at midnight UTC on that date. The date must be in the format YYYY-MM-DD.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Computed:         true,
-				ValidateDiagFunc: isISO6801Date,
-			},
-			"token": {
-				Description: "The personal access token. This is only populated when creating a new personal access token. This attribute is not available for imported resources.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-			},
-		},
+		Schema: gitlabUserTokenSchema("personal access token"),
 	}
 })
 
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,6 +45,11 @@ var (
 		"rebase_merge",
 		"ff",
 	}
+	validProjectDeletionModes = []string{
+		"archive",
+		"soft",
+		"permanent",
+	}
 )
 
 var resourceGitLabProjectSchema = map[string]*schema.Schema{
@@ -421,10 +428,13 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	"archive_on_destroy": {
-		Description: "Set to `true` to archive the project instead of deleting on destroy. If set to `true` it will entire omit the `DELETE` operation.",
-		Type:        schema.TypeBool,
-		Optional:    true,
+		Description:   "Set to `true` to archive the project instead of deleting on destroy. If set to `true` it will entire omit the `DELETE` operation.",
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"deletion_mode"},
 	},
+	"deletion_mode":            deletionModeSchema("project", validProjectDeletionModes, "`permanent` requires GitLab 15.11 or later. `archive` archives the project instead of deleting it. Defaults to `archive` if `archive_on_destroy` is `true`, otherwise to `soft`."),
+	"restore_pending_deletion": restorePendingDeletionSchema("project"),
 	"ci_forward_deployment_enabled": {
 		Description: "When a new deployment job starts, skip older deployment jobs that are still pending.",
 		Type:        schema.TypeBool,
@@ -514,7 +524,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validProjectAccessLevels, false)),
 	},
 	"container_expiration_policy": {
-		Description: "Set the image cleanup policy for this project. **Note**: this field is sometimes named `container_expiration_policy_attributes` in the GitLab Upstream API.",
+		Description: "Set the image cleanup policy for this project. **Note**: this field is sometimes named `container_expiration_policy_attributes` in the GitLab Upstream API. To manage the policy of a project owned by another configuration, use the `gitlab_project_container_expiration_policy` resource instead. Container registry protection rules are managed separately with the `gitlab_project_container_registry_protection_rule` resource, which only warns during planning when this policy may delete protected images. This resource doesn't check the policy against these rules.",
 		Type:        schema.TypeList,
 		MaxItems:    1,
 		Elem:        resourceContainerExpirationPolicyAttributesSchema,
@@ -684,18 +694,14 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 	},
 }
 
-var validContainerExpirationPolicyAttributesCadenceValues = []string{
-	"1d", "7d", "14d", "1month", "3month",
-}
-
 var resourceContainerExpirationPolicyAttributesSchema = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"cadence": {
-			Description:      fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(validContainerExpirationPolicyAttributesCadenceValues)),
+			Description:      fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidContainerExpirationPolicyCadenceValues)),
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validContainerExpirationPolicyAttributesCadenceValues, false)),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidContainerExpirationPolicyCadenceValues, false)),
 		},
 		"keep_n": {
 			Description:      "The number of images to keep.",
@@ -910,6 +916,27 @@ func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client,
 func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 
+	if _, ok := d.GetOk("forked_from_project_id"); !ok && d.Get("restore_pending_deletion").(bool) {
+		pendingDeletion, err := resourceGitlabProjectFindPendingDeletion(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if pendingDeletion != nil {
+			// NOTE: go-gitlab doesn't support restoring projects yet.
+			log.Printf("[DEBUG] restore gitlab project %q pending deletion", pendingDeletion.PathWithNamespace)
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%d/restore", pendingDeletion.ID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if _, err := client.Do(req, nil); err != nil {
+				return diag.Errorf("failed to restore project %q pending deletion: %v", pendingDeletion.PathWithNamespace, err)
+			}
+
+			d.SetId(fmt.Sprintf("%d", pendingDeletion.ID))
+			return resourceGitlabProjectUpdate(ctx, d, meta)
+		}
+	}
+
 	// Project that has either been created or forked
 	var project *gitlab.Project
 
@@ -1257,6 +1284,12 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 
 		project, _, err = client.Projects.CreateProject(options, gitlab.WithContext(ctx))
 		if err != nil {
+			// A project pending deletion keeps its path, therefore, we check if it is the reason for the conflict.
+			if strings.Contains(err.Error(), "has already been taken") {
+				if pendingDeletion, findErr := resourceGitlabProjectFindPendingDeletion(ctx, client, d); findErr == nil && pendingDeletion != nil {
+					return diag.Errorf("project %q is pending deletion and blocks its path, set `restore_pending_deletion` to `true` to restore it or remove it permanently", pendingDeletion.PathWithNamespace)
+				}
+			}
 			return diag.FromErr(err)
 		}
 	} else {
@@ -1836,7 +1869,8 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.Path = gitlab.String(d.Get("path").(string))
 	}
 
-	if d.HasChange("namespace_id") {
+	// NOTE: a project pending deletion which is restored on create is already in the configured namespace.
+	if d.HasChange("namespace_id") && !d.IsNewResource() {
 		transferOptions.Namespace = gitlab.Int(d.Get("namespace_id").(int))
 	}
 
@@ -2251,53 +2285,115 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 func resourceGitlabProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 
-	if !d.Get("archive_on_destroy").(bool) {
-		log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
-		_, err := client.Projects.DeleteProject(d.Id(), gitlab.WithContext(ctx))
+	deletionMode := resourceGitlabProjectDeletionMode(d)
+	if deletionMode == "archive" {
+		log.Printf("[DEBUG] Archive gitlab project %s", d.Id())
+		_, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		return nil
+	}
 
-		// Wait for the project to be deleted.
-		// Deleting a project in gitlab is async.
-		stateConf := &retry.StateChangeConf{
-			Pending: []string{"Deleting"},
-			Target:  []string{"Deleted"},
-			Refresh: func() (interface{}, string, error) {
-				out, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
-				if err != nil {
-					if api.Is404(err) {
-						return out, "Deleted", nil
-					}
-					log.Printf("[ERROR] Received error: %#v", err)
-					return out, "Error", err
-				}
-				if out.MarkedForDeletionAt != nil {
-					// Represents a Gitlab EE soft-delete
+	log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
+	_, err := client.Projects.DeleteProject(d.Id(), gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if deletionMode == "permanent" {
+		project, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
+		if err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+		if err == nil && project.MarkedForDeletionAt != nil {
+			log.Printf("[DEBUG] Permanently remove gitlab project %s marked for deletion", d.Id())
+			if err := permanentlyRemove(ctx, client, "projects", d.Id(), project.PathWithNamespace); err != nil {
+				return diag.Errorf("failed to permanently remove project %s: %v", d.Id(), err)
+			}
+		}
+	}
+
+	// Wait for the project to be deleted.
+	// Deleting a project in gitlab is async.
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			out, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
+			if err != nil {
+				if api.Is404(err) {
 					return out, "Deleted", nil
 				}
-				return out, "Deleting", nil
-			},
+				log.Printf("[ERROR] Received error: %#v", err)
+				return out, "Error", err
+			}
+			if out.MarkedForDeletionAt != nil && deletionMode != "permanent" {
+				// Represents a Gitlab EE soft-delete
+				return out, "Deleted", nil
+			}
+			return out, "Deleting", nil
+		},
 
-			Timeout:    10 * time.Minute,
-			MinTimeout: 3 * time.Second,
-			Delay:      5 * time.Second,
-		}
+		Timeout:    10 * time.Minute,
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for project (%s) to become deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+// resourceGitlabProjectDeletionMode returns the configured deletion mode,
+// falling back to `archive_on_destroy` if no deletion mode is configured.
+func resourceGitlabProjectDeletionMode(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("deletion_mode"); ok {
+		return v.(string)
+	}
+	if d.Get("archive_on_destroy").(bool) {
+		return "archive"
+	}
+	return "soft"
+}
+
+// resourceGitlabProjectFindPendingDeletion returns the project pending deletion at the configured path, if any.
+// Without a configured path nothing is found, because GitLab derives the path from the name.
+func resourceGitlabProjectFindPendingDeletion(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) (*gitlab.Project, error) {
+	path, ok := d.GetOk("path")
+	if !ok {
+		return nil, nil
+	}
+
+	var namespace string
+	if v, ok := d.GetOk("namespace_id"); ok {
+		n, _, err := client.Namespaces.GetNamespace(v.(int), gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("error waiting for project (%s) to become deleted: %s", d.Id(), err)
+			return nil, err
 		}
-
+		namespace = n.FullPath
 	} else {
-		log.Printf("[DEBUG] Archive gitlab project %s", d.Id())
-		_, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx))
+		currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
+		namespace = currentUser.Username
 	}
 
-	return nil
+	project, _, err := client.Projects.GetProject(fmt.Sprintf("%s/%s", namespace, path.(string)), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if project.MarkedForDeletionAt == nil {
+		return nil, nil
+	}
+	return project, nil
 }
 
 func editOrAddPushRules(ctx context.Context, client *gitlab.Client, projectID string, d *schema.ResourceData) error {
@@ -0,0 +1,288 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_project_default_branch", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_default_branch`" + ` resource allows to manage the default branch of a project
+and to safely rename it, e.g. from ` + "`master`" + ` to ` + "`main`" + `.
+
+When the branch changes, the new branch is created from the current default branch if it doesn't exist yet,
+the protection of the current default branch is copied to the new branch, the new branch is set as the default branch
+and finally the previous default branch is unprotected and, unless ` + "`delete_previous_branch`" + ` is ` + "`false`" + `, deleted.
+
+-> Destroying the resource doesn't change the default branch of the project.
+
+~> Don't use this resource together with the ` + "`default_branch`" + ` attribute of the ` + "`gitlab_project`" + ` resource.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#edit-project)`,
+
+		CreateContext: resourceGitlabProjectDefaultBranchCreate,
+		ReadContext:   resourceGitlabProjectDefaultBranchRead,
+		UpdateContext: resourceGitlabProjectDefaultBranchUpdate,
+		DeleteContext: resourceGitlabProjectDefaultBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description:      "The name of the default branch.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			"delete_previous_branch": {
+				Description: "Delete the previous default branch after renaming it. Defaults to `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"validate_codeowners": {
+				Description: "Validate the CODEOWNERS file in the default branch and report its syntax errors. The file is validated before the default branch is changed. Requires a GitLab Premium or Ultimate license.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectDefaultBranchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+
+	// NOTE: the CODEOWNERS file is validated before the branch is renamed, so that an invalid file doesn't change anything.
+	diags := resourceGitlabProjectDefaultBranchValidateCodeowners(ctx, meta.(*gitlab.Client), d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := resourceGitlabProjectDefaultBranchRename(ctx, meta.(*gitlab.Client), d); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	d.SetId(project)
+	return append(diags, resourceGitlabProjectDefaultBranchRead(ctx, d, meta)...)
+}
+
+func resourceGitlabProjectDefaultBranchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read default branch of project %q", project)
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] project %q not found, removing default branch from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("branch", p.DefaultBranch)
+	return nil
+}
+
+func resourceGitlabProjectDefaultBranchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.HasChanges("branch", "validate_codeowners") {
+		diags = resourceGitlabProjectDefaultBranchValidateCodeowners(ctx, meta.(*gitlab.Client), d)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("branch") {
+		if err := resourceGitlabProjectDefaultBranchRename(ctx, meta.(*gitlab.Client), d); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceGitlabProjectDefaultBranchRead(ctx, d, meta)...)
+}
+
+func resourceGitlabProjectDefaultBranchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] default branch of project %q is not changed, only removing it from state", d.Id())
+	return nil
+}
+
+// resourceGitlabProjectDefaultBranchRename renames the current default branch of the project to the configured branch.
+func resourceGitlabProjectDefaultBranchRename(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) error {
+	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
+
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get project %q: %w", project, err)
+	}
+	previousBranch := p.DefaultBranch
+	if previousBranch == branch {
+		log.Printf("[DEBUG] %q already is the default branch of project %q", branch, project)
+		return nil
+	}
+
+	log.Printf("[DEBUG] rename default branch of project %q from %q to %q", project, previousBranch, branch)
+
+	if _, _, err := client.Branches.GetBranch(project, branch, gitlab.WithContext(ctx)); err != nil {
+		if !api.Is404(err) {
+			return fmt.Errorf("failed to get branch %q of project %q: %w", branch, project, err)
+		}
+		log.Printf("[DEBUG] create branch %q from %q in project %q", branch, previousBranch, project)
+		if _, _, err := client.Branches.CreateBranch(project, &gitlab.CreateBranchOptions{
+			Branch: gitlab.String(branch),
+			Ref:    gitlab.String(previousBranch),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to create branch %q from %q in project %q: %w", branch, previousBranch, project, err)
+		}
+	}
+
+	// Protect the new branch before it becomes the default branch, so that it's never unprotected.
+	previousProtectedBranch, _, err := client.ProtectedBranches.GetProtectedBranch(project, previousBranch, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		return fmt.Errorf("failed to get protection of branch %q of project %q: %w", previousBranch, project, err)
+	}
+	if previousProtectedBranch != nil {
+		_, _, err := client.ProtectedBranches.GetProtectedBranch(project, branch, gitlab.WithContext(ctx))
+		switch {
+		case api.Is404(err):
+			log.Printf("[DEBUG] copy protection of branch %q to %q in project %q", previousBranch, branch, project)
+			if _, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project, copyProtectRepositoryBranchesOptions(branch, previousProtectedBranch), gitlab.WithContext(ctx)); err != nil {
+				return fmt.Errorf("failed to protect branch %q of project %q: %w", branch, project, err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to get protection of branch %q of project %q: %w", branch, project, err)
+		default:
+			log.Printf("[DEBUG] branch %q of project %q is already protected, keeping its protection", branch, project)
+		}
+	}
+
+	if _, _, err := client.Projects.EditProject(project, &gitlab.EditProjectOptions{DefaultBranch: gitlab.String(branch)}, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to set default branch of project %q to %q: %w", project, branch, err)
+	}
+
+	if previousProtectedBranch != nil {
+		log.Printf("[DEBUG] unprotect previous default branch %q of project %q", previousBranch, project)
+		if _, err := client.ProtectedBranches.UnprotectRepositoryBranches(project, previousBranch, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return fmt.Errorf("failed to unprotect previous default branch %q of project %q: %w", previousBranch, project, err)
+		}
+	}
+
+	if d.Get("delete_previous_branch").(bool) {
+		log.Printf("[DEBUG] delete previous default branch %q of project %q", previousBranch, project)
+		if _, err := client.Branches.DeleteBranch(project, previousBranch, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return fmt.Errorf("failed to delete previous default branch %q of project %q: %w", previousBranch, project, err)
+		}
+	}
+
+	return nil
+}
+
+type codeownersValidationResponse struct {
+	Data struct {
+		Project *struct {
+			Repository *struct {
+				ValidateCodeownerFile *struct {
+					Total            int `json:"total"`
+					ValidationErrors []struct {
+						Code  string `json:"code"`
+						Lines []int  `json:"lines"`
+					} `json:"validationErrors"`
+				} `json:"validateCodeownerFile"`
+			} `json:"repository"`
+		} `json:"project"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// resourceGitlabProjectDefaultBranchValidateCodeowners reports the syntax errors of the CODEOWNERS file
+// of the configured branch as diagnostics, if `validate_codeowners` is enabled.
+// If the branch doesn't exist yet, the current default branch it will be created from is validated instead.
+func resourceGitlabProjectDefaultBranchValidateCodeowners(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) diag.Diagnostics {
+	if !d.Get("validate_codeowners").(bool) {
+		return nil
+	}
+
+	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
+
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get project %q: %v", project, err)
+	}
+	ref := branch
+	if _, _, err := client.Branches.GetBranch(project, branch, gitlab.WithContext(ctx)); err != nil {
+		if !api.Is404(err) {
+			return diag.Errorf("failed to get branch %q of project %q: %v", branch, project, err)
+		}
+		ref = p.DefaultBranch
+	}
+
+	// NOTE: the CODEOWNERS validation is only available in the GraphQL API.
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			query {
+				project(fullPath: "%s") {
+					repository {
+						validateCodeownerFile(ref: "%s") {
+							total
+							validationErrors {
+								code
+								lines
+							}
+						}
+					}
+				}
+			}`, p.PathWithNamespace, ref),
+	}
+	log.Printf("[DEBUG] executing GraphQL Query %s", query.Query)
+
+	var response codeownersValidationResponse
+	if _, err := api.SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to validate CODEOWNERS file in branch %q of project %q: %v", ref, project, err)
+	}
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return diag.Errorf("failed to validate CODEOWNERS file in branch %q of project %q: %s", ref, project, strings.Join(messages, ", "))
+	}
+	if response.Data.Project == nil || response.Data.Project.Repository == nil || response.Data.Project.Repository.ValidateCodeownerFile == nil {
+		return diag.Errorf("failed to validate CODEOWNERS file in branch %q of project %q: no validation result returned", ref, project)
+	}
+
+	var diags diag.Diagnostics
+	for _, validationError := range response.Data.Project.Repository.ValidateCodeownerFile.ValidationErrors {
+		lines := make([]string, 0, len(validationError.Lines))
+		for _, line := range validationError.Lines {
+			lines = append(lines, strconv.Itoa(line))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid CODEOWNERS file",
+			Detail:   fmt.Sprintf("The CODEOWNERS file in branch %q of project %q has the error %q on line(s) %s.", ref, project, validationError.Code, strings.Join(lines, ", ")),
+		})
+	}
+	return diags
+}
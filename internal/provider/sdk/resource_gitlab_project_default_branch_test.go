@@ -0,0 +1,200 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectDefaultBranch_rename(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	previousBranch := testProject.DefaultBranch
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectDefaultBranchIs(testProject.ID, previousBranch),
+		Steps: []resource.TestStep{
+			// Rename the default branch
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_default_branch" "this" {
+						project = %d
+						branch  = "trunk"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_default_branch.this", "branch", "trunk"),
+					testAccCheckGitlabProjectDefaultBranchIs(testProject.ID, "trunk"),
+					func(_ *terraform.State) error {
+						if _, _, err := testutil.TestGitlabClient.Branches.GetBranch(testProject.ID, previousBranch); !api.Is404(err) {
+							return fmt.Errorf("previous default branch %q still exists", previousBranch)
+						}
+						if _, _, err := testutil.TestGitlabClient.ProtectedBranches.GetProtectedBranch(testProject.ID, "trunk"); err != nil {
+							return fmt.Errorf("protection was not moved to branch %q: %v", "trunk", err)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_default_branch.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_previous_branch"},
+			},
+			// Rename the default branch back without deleting the previous branch
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_default_branch" "this" {
+						project                = %d
+						branch                 = "%s"
+						delete_previous_branch = false
+					}
+				`, testProject.ID, previousBranch),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectDefaultBranchIs(testProject.ID, previousBranch),
+					func(_ *terraform.State) error {
+						if _, _, err := testutil.TestGitlabClient.Branches.GetBranch(testProject.ID, "trunk"); err != nil {
+							return fmt.Errorf("previous default branch %q was deleted: %v", "trunk", err)
+						}
+						if _, _, err := testutil.TestGitlabClient.ProtectedBranches.GetProtectedBranch(testProject.ID, "trunk"); !api.Is404(err) {
+							return fmt.Errorf("previous default branch %q is still protected", "trunk")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectDefaultBranch_copyAllowedTo(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProject := testutil.CreateProject(t)
+	testUser := testutil.CreateUsers(t, 1)[0]
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testutil.AddProjectMembers(t, testProject.ID, []*gitlab.User{testUser})
+	testutil.ProjectShareGroup(t, testProject.ID, testGroup.ID)
+
+	// Protect the default branch with user and group specific access levels in addition to the role based ones
+	if _, err := testutil.TestGitlabClient.ProtectedBranches.UnprotectRepositoryBranches(testProject.ID, testProject.DefaultBranch); err != nil && !api.Is404(err) {
+		t.Fatalf("failed to unprotect branch %q: %v", testProject.DefaultBranch, err)
+	}
+	if _, _, err := testutil.TestGitlabClient.ProtectedBranches.ProtectRepositoryBranches(testProject.ID, &gitlab.ProtectRepositoryBranchesOptions{
+		Name:             gitlab.String(testProject.DefaultBranch),
+		PushAccessLevel:  gitlab.AccessLevel(gitlab.MaintainerPermissions),
+		MergeAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+		AllowedToPush: &[]*gitlab.BranchPermissionOptions{
+			{UserID: gitlab.Int(testUser.ID)},
+			{GroupID: gitlab.Int(testGroup.ID)},
+		},
+		AllowedToMerge: &[]*gitlab.BranchPermissionOptions{
+			{AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)},
+			{UserID: gitlab.Int(testUser.ID)},
+		},
+	}); err != nil {
+		t.Fatalf("failed to protect branch %q: %v", testProject.DefaultBranch, err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectDefaultBranchIs(testProject.ID, testProject.DefaultBranch),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_default_branch" "this" {
+						project                = %d
+						branch                 = "trunk"
+						delete_previous_branch = false
+					}
+				`, testProject.ID),
+				Check: func(_ *terraform.State) error {
+					pb, _, err := testutil.TestGitlabClient.ProtectedBranches.GetProtectedBranch(testProject.ID, "trunk")
+					if err != nil {
+						return fmt.Errorf("protection was not moved to branch %q: %v", "trunk", err)
+					}
+
+					hasAccess := func(descriptions []*gitlab.BranchAccessDescription, accessLevel gitlab.AccessLevelValue, userID int, groupID int) bool {
+						for _, description := range descriptions {
+							if description.UserID == userID && description.GroupID == groupID && (userID != 0 || groupID != 0 || description.AccessLevel == accessLevel) {
+								return true
+							}
+						}
+						return false
+					}
+					switch {
+					case !hasAccess(pb.PushAccessLevels, gitlab.MaintainerPermissions, 0, 0):
+						return fmt.Errorf("push access level of maintainers was not copied")
+					case !hasAccess(pb.PushAccessLevels, 0, testUser.ID, 0):
+						return fmt.Errorf("push access of user %d was not copied", testUser.ID)
+					case !hasAccess(pb.PushAccessLevels, 0, 0, testGroup.ID):
+						return fmt.Errorf("push access of group %d was not copied", testGroup.ID)
+					case !hasAccess(pb.MergeAccessLevels, gitlab.MaintainerPermissions, 0, 0):
+						return fmt.Errorf("merge access level of maintainers was not copied")
+					case !hasAccess(pb.MergeAccessLevels, gitlab.DeveloperPermissions, 0, 0):
+						return fmt.Errorf("merge access level of developers was not copied")
+					case !hasAccess(pb.MergeAccessLevels, 0, testUser.ID, 0):
+						return fmt.Errorf("merge access of user %d was not copied", testUser.ID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectDefaultBranch_validateCodeowners(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProject := testutil.CreateProject(t)
+	if err := api.CommitRepositoryFile(context.Background(), testutil.TestGitlabClient, fmt.Sprintf("%d", testProject.ID), "CODEOWNERS", "[Section\n* @\n", &api.RepositoryFileCommitOptions{
+		Branch:        testProject.DefaultBranch,
+		CommitMessage: "Add invalid CODEOWNERS",
+		Timeout:       time.Minute,
+	}); err != nil {
+		t.Fatalf("failed to commit CODEOWNERS file: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_default_branch" "this" {
+						project             = %d
+						branch              = "%s"
+						validate_codeowners = true
+					}
+				`, testProject.ID, testProject.DefaultBranch),
+				ExpectError: regexp.MustCompile(`Invalid CODEOWNERS file`),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectDefaultBranchIs(project int, branch string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		p, _, err := testutil.TestGitlabClient.Projects.GetProject(project, nil)
+		if err != nil {
+			return err
+		}
+		if p.DefaultBranch != branch {
+			return fmt.Errorf("expected default branch %q, got %q", branch, p.DefaultBranch)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_project_import", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_import`" + ` resource allows to create a project by importing a GitLab export archive,
+e.g. one downloaded with the ` + "`gitlab_project_export`" + ` data source.
+
+The archive is uploaded from the local file system and the resource waits until the import finished.
+A failed import is reported with the import error of GitLab.
+
+-> **Timeouts** Default timeout for *Create* is 20 minutes and can be configured in the ` + "`timeouts`" + ` block.
+
+~> Destroying this resource deletes the imported project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_import_export.html#import-a-file)`,
+
+		CreateContext: resourceGitlabProjectImportCreate,
+		ReadContext:   resourceGitlabProjectImportRead,
+		DeleteContext: resourceGitlabProjectImportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"file": {
+				Description: "The local path to the export archive (`.tar.gz`) to import.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"path": {
+				Description: "The path of the imported project.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the imported project. Defaults to the path.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+				Computed:    true,
+			},
+			"namespace": {
+				Description: "The ID or path of the namespace to import the project into. Defaults to the namespace of the current user.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+			},
+			"overwrite": {
+				Description: "Whether to overwrite an existing project with the same path.",
+				Type:        schema.TypeBool,
+				ForceNew:    true,
+				Optional:    true,
+			},
+			"path_with_namespace": {
+				Description: "The path of the imported project with namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"import_status": {
+				Description: "The status of the import, e.g. `finished`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	file := d.Get("file").(string)
+
+	options := &gitlab.ImportFileOptions{
+		Path: gitlab.String(d.Get("path").(string)),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("namespace"); ok {
+		options.Namespace = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("overwrite"); ok {
+		options.Overwrite = gitlab.Bool(v.(bool))
+	}
+
+	archive, err := os.Open(file)
+	if err != nil {
+		return diag.Errorf("failed to open export archive %q: %v", file, err)
+	}
+	defer archive.Close()
+
+	log.Printf("[DEBUG] import gitlab project %q from %q", *options.Path, file)
+	importStatus, _, err := client.ProjectImportExport.ImportFromFile(archive, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", importStatus.ID))
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"none", "scheduled", "started"},
+		Target:  []string{"finished"},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := client.ProjectImportExport.ImportStatus(d.Id(), gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+			if status.ImportStatus == "failed" {
+				return status, status.ImportStatus, fmt.Errorf("import failed: %s", status.ImportError)
+			}
+
+			return status, status.ImportStatus, nil
+		},
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error while waiting for project %q import to finish: %s", *options.Path, err)
+	}
+
+	return resourceGitlabProjectImportRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab project import %s", d.Id())
+	importStatus, _, err := client.ProjectImportExport.ImportStatus(d.Id(), gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing import from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("path", importStatus.Path)
+	d.Set("name", importStatus.Name)
+	d.Set("path_with_namespace", importStatus.PathWithNamespace)
+	d.Set("import_status", importStatus.ImportStatus)
+	return nil
+}
+
+func resourceGitlabProjectImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] delete imported gitlab project %s", d.Id())
+	if _, err := client.Projects.DeleteProject(d.Id(), gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectImport_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	file := filepath.Join(t.TempDir(), "export.tar.gz")
+	path := acctest.RandomWithPrefix("acctest-import")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectImportDestroy,
+		Steps: []resource.TestStep{
+			// Import a project from the export of another project
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_export" "this" {
+						project = "%d"
+						file    = "%s"
+					}
+
+					resource "gitlab_project_import" "this" {
+						file      = data.gitlab_project_export.this.file
+						path      = "%s"
+						namespace = "%s"
+					}
+				`, testProject.ID, file, path, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_import.this", "import_status", "finished"),
+					resource.TestCheckResourceAttr("gitlab_project_import.this", "path_with_namespace", fmt.Sprintf("%s/%s", testGroup.FullPath, path)),
+					func(s *terraform.State) error {
+						project, _, err := testutil.TestGitlabClient.Projects.GetProject(s.RootModule().Resources["gitlab_project_import.this"].Primary.ID, nil)
+						if err != nil {
+							return err
+						}
+						if project.Description != testProject.Description {
+							return fmt.Errorf("expected imported project description %q, got %q", testProject.Description, project.Description)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_import.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "namespace", "overwrite"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectImportDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_import" {
+			continue
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err == nil {
+			if project.MarkedForDeletionAt == nil {
+				return fmt.Errorf("imported project %s still exists", rs.Primary.ID)
+			}
+			continue
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectInvitation_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("acctest"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabInvitationDestroy("gitlab_project_invitation"),
+		Steps: []resource.TestStep{
+			// Invite a user by email
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_invitation" "this" {
+						project      = "%d"
+						email        = "%s"
+						access_level = "reporter"
+						expires_at   = "2099-01-01"
+					}
+				`, project.ID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_invitation.this", "pending", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_invitation.this", "expires_at", "2099-01-01"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_invitation.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the access level and remove the expiry
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_invitation" "this" {
+						project      = "%d"
+						email        = "%s"
+						access_level = "developer"
+					}
+				`, project.ID, email),
+				Check: resource.TestCheckResourceAttr("gitlab_project_invitation.this", "access_level", "developer"),
+			},
+		},
+	})
+}
@@ -17,7 +17,8 @@ var _ = registerResource("gitlab_project_issue_board", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_project_issue_board` + "`" + ` resource allows to manage the lifecycle of a Project Issue Board.
 
-~> **NOTE:** If the board lists are changed all lists will be recreated.
+-> Changes to the board lists are applied in-place: lists which are not declared anymore are deleted,
+   new lists are created and existing lists are moved to their new position, so that they keep their IDs.
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/boards.html)`,
 
@@ -73,8 +74,9 @@ func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.Resour
 	}
 
 	if v, ok := d.GetOk("lists"); ok {
-		if err = resourceGitlabProjectIssueBoardCreateLists(ctx, client, project, issueBoard, v.([]interface{})); err != nil {
-			return diag.FromErr(err)
+		log.Printf("[DEBUG] creating lists for Project Issue Board %q in project %q", issueBoard.Name, project)
+		if err = updateBoardLists(issueBoard.Lists, v.([]interface{}), resourceGitlabProjectIssueBoardListOperations(ctx, client, project, issueBoard.ID)); err != nil {
+			return diag.Errorf("failed to create lists for Project Issue Board %q in project %q: %s", issueBoard.Name, project, err)
 		}
 	}
 
@@ -138,19 +140,9 @@ func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	if d.HasChange("lists") {
-		// NOTE: since we do not have a straightforward way to know which lists have been changed, we just re-create all lists
-		log.Printf("[DEBUG] deleting lists for Project Issue Board %q in project %q", updatedIssueBoard.Name, project)
-		for _, list := range updatedIssueBoard.Lists {
-			log.Printf("[DEBUG] deleting list %d for Project Issue Board %q in project %q", list.ID, updatedIssueBoard.Name, project)
-			_, err := client.Boards.DeleteIssueBoardList(project, issueBoardID, list.ID, gitlab.WithContext(ctx))
-			if err != nil {
-				return diag.Errorf("failed to delete list %q for Project Issue Board %q in project %q: %s", list.ID, updatedIssueBoard.Name, project, err)
-			}
-		}
-		log.Printf("[DEBUG] deleted lists for Project Issue Board %q in project %q", updatedIssueBoard.Name, project)
-
-		if err = resourceGitlabProjectIssueBoardCreateLists(ctx, client, project, updatedIssueBoard, d.Get("lists").([]interface{})); err != nil {
-			return diag.FromErr(err)
+		log.Printf("[DEBUG] updating lists for Project Issue Board %q in project %q", updatedIssueBoard.Name, project)
+		if err = updateBoardLists(updatedIssueBoard.Lists, d.Get("lists").([]interface{}), resourceGitlabProjectIssueBoardListOperations(ctx, client, project, issueBoardID)); err != nil {
+			return diag.Errorf("failed to update lists for Project Issue Board %q in project %q: %s", updatedIssueBoard.Name, project, err)
 		}
 	}
 
@@ -190,33 +182,22 @@ func resourceGitlabProjectIssueBoardParseID(id string) (string, int, error) {
 	return project, issueBoardID, nil
 }
 
-func resourceGitlabProjectIssueBoardCreateLists(ctx context.Context, client *gitlab.Client, project string, issueBoard *gitlab.IssueBoard, lists []interface{}) error {
-	log.Printf("[DEBUG] creating lists for Project Issue Board %q in project %q", issueBoard.Name, project)
-	for i, listData := range lists {
-		position := i + 1
-		log.Printf("[DEBUG] creating list at position %d for Project Issue Board %q in project %q", position, issueBoard.Name, project)
-
-		listOptions := gitlab.CreateIssueBoardListOptions{}
-		if listData != nil {
-			l := listData.(map[string]interface{})
-			if v, ok := l["label_id"]; ok && v != 0 {
-				listOptions.LabelID = gitlab.Int(v.(int))
-			}
-			if v, ok := l["assignee_id"]; ok && v != 0 {
-				listOptions.AssigneeID = gitlab.Int(v.(int))
-			}
-			if v, ok := l["milestone_id"]; ok && v != 0 {
-				listOptions.MilestoneID = gitlab.Int(v.(int))
+func resourceGitlabProjectIssueBoardListOperations(ctx context.Context, client *gitlab.Client, project string, issueBoardID int) boardListOperations {
+	return boardListOperations{
+		create: func(scope boardListScope) (int, error) {
+			list, _, err := client.Boards.CreateIssueBoardList(project, issueBoardID, scope.createIssueBoardListOptions(), gitlab.WithContext(ctx))
+			if err != nil {
+				return 0, err
 			}
-		}
-
-		list, _, err := client.Boards.CreateIssueBoardList(project, issueBoard.ID, &listOptions, gitlab.WithContext(ctx))
-		if err != nil {
-			return fmt.Errorf("failed to create list at position %d for Project Issue Board %q in project %q: %s", position, issueBoard.Name, project, err)
-		}
-
-		log.Printf("[DEBUG] created list at position %d for Project Issue Board %q in project %q", list.Position, issueBoard.Name, project)
+			return list.ID, nil
+		},
+		move: func(listID int, position int) error {
+			_, _, err := client.Boards.UpdateIssueBoardList(project, issueBoardID, listID, &gitlab.UpdateIssueBoardListOptions{Position: gitlab.Int(position)}, gitlab.WithContext(ctx))
+			return err
+		},
+		delete: func(listID int) error {
+			_, err := client.Boards.DeleteIssueBoardList(project, issueBoardID, listID, gitlab.WithContext(ctx))
+			return err
+		},
 	}
-
-	return nil
 }
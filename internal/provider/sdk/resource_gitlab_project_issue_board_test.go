@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -265,6 +266,99 @@ func TestAccGitlabProjectIssueBoard_Lists(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectIssueBoard_reorderLists(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testLabels := testutil.CreateProjectLabels(t, testProject.ID, 3)
+
+	// NOTE: there is no way to delete the last issue board, see
+	// https://gitlab.com/gitlab-org/gitlab/-/issues/367395
+	testutil.CreateProjectIssueBoard(t, testProject.ID)
+
+	listIDs := map[string]string{}
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectIssueBoardDestroy,
+		Steps: []resource.TestStep{
+			// Create Board with 3 lists
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_issue_board" "this" {
+						project = "%d"
+						name    = "Test Board"
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+					}
+				`, testProject.ID, testLabels[0].ID, testLabels[1].ID, testLabels[2].ID),
+				Check: testAccCheckGitlabBoardListIDs("gitlab_project_issue_board.this", listIDs),
+			},
+			// Reorder the lists and remove a list in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_issue_board" "this" {
+						project = "%d"
+						name    = "Test Board"
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+					}
+				`, testProject.ID, testLabels[2].ID, testLabels[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.0.label_id", fmt.Sprintf("%d", testLabels[2].ID)),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.1.label_id", fmt.Sprintf("%d", testLabels[0].ID)),
+					testAccCheckGitlabBoardListIDs("gitlab_project_issue_board.this", listIDs),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project_issue_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabBoardListIDs records the IDs of the board lists by their label in `listIDs`
+// and verifies that lists which were already recorded kept their IDs, i.e. they were not recreated.
+func testAccCheckGitlabBoardListIDs(resourceName string, listIDs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["lists.#"])
+		if err != nil {
+			return err
+		}
+		for i := 0; i < count; i++ {
+			labelID := rs.Primary.Attributes[fmt.Sprintf("lists.%d.label_id", i)]
+			listID := rs.Primary.Attributes[fmt.Sprintf("lists.%d.id", i)]
+			if previousListID, ok := listIDs[labelID]; ok && previousListID != listID {
+				return fmt.Errorf("list for label %s was recreated: id changed from %s to %s", labelID, previousListID, listID)
+			}
+			listIDs[labelID] = listID
+		}
+		return nil
+	}
+}
+
 func testAccCheckGitlabProjectIssueBoardDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_issue_board" {
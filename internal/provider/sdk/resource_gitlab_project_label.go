@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -43,6 +44,10 @@ var _ = registerResource("gitlab_project_label", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_project_label` + "`" + ` resource allows to manage the lifecycle of a project label.
 
+-> Setting ` + "`promote_to_group`" + ` promotes the project label to a group label of the parent group, keeping its issue and merge request associations.
+   A promoted label can't be changed with this resource anymore and destroying the resource only removes it from the state.
+   Use the ` + "`gitlab_group_label`" + ` resource to manage the promoted label instead.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/labels.html#project-labels)`,
 
 		CreateContext: resourceGitlabProjectLabelCreate,
@@ -92,6 +97,17 @@ func gitlabProjectLabelSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"priority": gitlabLabelPrioritySchema(),
+		"promote_to_group": {
+			Description: "Promote the project label to a group label of the parent group, keeping its issue and merge request associations. The promotion can't be undone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"is_project_label": {
+			Description: "Whether the label is still a project label, i.e. it wasn't promoted to a group label.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
 	}
 }
 
@@ -129,8 +145,9 @@ func resourceGitlabProjectLabelCreate(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*gitlab.Client)
 	project := d.Get("project").(string)
 	options := &gitlab.CreateLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
-		Color: gitlab.String(d.Get("color").(string)),
+		Name:     gitlab.String(d.Get("name").(string)),
+		Color:    gitlab.String(d.Get("color").(string)),
+		Priority: labelPriorityToOption(d.Get("priority").(int)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -145,6 +162,13 @@ func resourceGitlabProjectLabelCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(resourceGitlabProjectLabelBuildId(project, label.Name))
+
+	if d.Get("promote_to_group").(bool) {
+		if err := resourceGitlabProjectLabelPromote(ctx, client, project, label.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGitlabProjectLabelRead(ctx, d, meta)
 }
 
@@ -171,6 +195,8 @@ func resourceGitlabProjectLabelRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("description", label.Description)
 	d.Set("color", label.Color)
 	d.Set("name", label.Name)
+	d.Set("priority", label.Priority)
+	d.Set("is_project_label", label.IsProjectLabel)
 	return nil
 }
 
@@ -180,20 +206,34 @@ func resourceGitlabProjectLabelUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.Errorf("Failed to parse project label id %q: %s", d.Id(), err)
 	}
-	options := &gitlab.UpdateLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
-		Color: gitlab.String(d.Get("color").(string)),
-	}
 
-	if d.HasChange("description") {
-		options.Description = gitlab.String(d.Get("description").(string))
-	}
+	if d.HasChanges("color", "description", "priority") {
+		if !d.Get("is_project_label").(bool) {
+			return diag.Errorf("label %q was promoted to a group label and can't be changed anymore, use the `gitlab_group_label` resource instead", d.Get("name").(string))
+		}
 
-	log.Printf("[DEBUG] update gitlab label %s", d.Id())
+		options := &updateLabelOptions{
+			Name:     gitlab.String(d.Get("name").(string)),
+			Color:    gitlab.String(d.Get("color").(string)),
+			Priority: labelPriorityToOption(d.Get("priority").(int)),
+		}
 
-	_, _, err = client.Labels.UpdateLabel(project, options, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
+		if d.HasChange("description") {
+			options.Description = gitlab.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] update gitlab label %s", d.Id())
+
+		// NOTE: go-gitlab doesn't support removing the priority of a label yet.
+		if err := updateLabel(ctx, client, "projects", project, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("promote_to_group") && d.Get("promote_to_group").(bool) && d.Get("is_project_label").(bool) {
+		if err := resourceGitlabProjectLabelPromote(ctx, client, project, d.Get("label_id").(int)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceGitlabProjectLabelRead(ctx, d, meta)
@@ -205,6 +245,19 @@ func resourceGitlabProjectLabelDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.Errorf("Failed to parse project label id %q: %s", d.Id(), err)
 	}
+
+	label, _, err := client.Labels.GetLabel(project, labelName, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !label.IsProjectLabel {
+		log.Printf("[DEBUG] gitlab label %s was promoted to a group label, only removing it from state", d.Id())
+		return nil
+	}
+
 	log.Printf("[DEBUG] Delete gitlab label %s", d.Id())
 	options := &gitlab.DeleteLabelOptions{
 		Name: gitlab.String(labelName),
@@ -213,3 +266,12 @@ func resourceGitlabProjectLabelDelete(ctx context.Context, d *schema.ResourceDat
 	_, err = client.Labels.DeleteLabel(project, options, gitlab.WithContext(ctx))
 	return diag.FromErr(err)
 }
+
+// resourceGitlabProjectLabelPromote promotes the project label to a group label of the parent group.
+func resourceGitlabProjectLabelPromote(ctx context.Context, client *gitlab.Client, project string, labelID int) error {
+	log.Printf("[DEBUG] promote gitlab label %d of project %s to a group label", labelID, project)
+	if _, err := client.Labels.PromoteLabel(project, labelID, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to promote label %d of project %s to a group label: %w", labelID, project, err)
+	}
+	return nil
+}
@@ -95,6 +95,7 @@ func TestAccGitlabProjectLabel_basic(t *testing.T) {
 						Color:       "#ff0000",
 						Description: "red label",
 					}),
+					resource.TestCheckResourceAttr("gitlab_project_label.fixme", "priority", "3"),
 				),
 			},
 			// Update the label to get back to initial settings
@@ -107,6 +108,7 @@ func TestAccGitlabProjectLabel_basic(t *testing.T) {
 						Color:       "#ffcc00",
 						Description: "fix this test",
 					}),
+					resource.TestCheckResourceAttr("gitlab_project_label.fixme", "priority", "0"),
 				),
 			},
 			// Verify Import
@@ -119,6 +121,64 @@ func TestAccGitlabProjectLabel_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectLabel_promoteToGroup(t *testing.T) {
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testProject := testutil.CreateProjectWithNamespace(t, testGroup.ID)
+	testIssue := testutil.CreateProjectIssues(t, testProject.ID, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			// Create a project label and assign it to an issue
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_label" "this" {
+						project = %d
+						name    = "promote-me"
+						color   = "#ffcc00"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_label.this", "is_project_label", "true"),
+					func(_ *terraform.State) error {
+						_, _, err := testutil.TestGitlabClient.Issues.UpdateIssue(testProject.ID, testIssue.IID, &gitlab.UpdateIssueOptions{
+							AddLabels: &gitlab.Labels{"promote-me"},
+						})
+						return err
+					},
+				),
+			},
+			// Promote the label to a group label
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_label" "this" {
+						project          = %d
+						name             = "promote-me"
+						color            = "#ffcc00"
+						promote_to_group = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_label.this", "is_project_label", "false"),
+					func(_ *terraform.State) error {
+						if _, _, err := testutil.TestGitlabClient.GroupLabels.GetGroupLabel(testGroup.ID, "promote-me"); err != nil {
+							return fmt.Errorf("label was not promoted to a group label: %v", err)
+						}
+						issue, _, err := testutil.TestGitlabClient.Issues.GetIssue(testProject.ID, testIssue.IID)
+						if err != nil {
+							return err
+						}
+						if len(issue.Labels) != 1 || issue.Labels[0] != "promote-me" {
+							return fmt.Errorf("promoted label is not assigned to the issue anymore, got labels %v", issue.Labels)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectLabelExists(n string, label *gitlab.Label) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -220,6 +280,7 @@ resource "gitlab_project_label" "fixme" {
   name = "FIXME-%d"
   color = "#ff0000"
   description = "red label"
+  priority = 3
 }
 	`, rInt, rInt)
 }
@@ -0,0 +1,155 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_project_labels", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_labels`" + ` resource allows to authoritatively manage all labels of a project.
+
+Labels which are not declared, e.g. because they were added in the UI, are detected and deleted on the next apply.
+Labels inherited from ancestor groups are not managed by this resource.
+
+~> This resource must not be used together with the ` + "`gitlab_project_label`" + ` resource for the same project, because they will fight over the labels.
+
+-> Changed labels are updated in-place, so that their issue and merge request associations are kept.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/labels.html)`,
+
+		CreateContext: resourceGitlabProjectLabelsSet,
+		ReadContext:   resourceGitlabProjectLabelsRead,
+		UpdateContext: resourceGitlabProjectLabelsSet,
+		DeleteContext: resourceGitlabProjectLabelsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"labels": gitlabLabelSetSchema(),
+		},
+	}
+})
+
+func resourceGitlabProjectLabelsSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	current, err := listProjectLabels(ctx, client, project, false, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired, err := expandGitlabLabelSet(d.Get("labels").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing := map[string]bool{}
+	for _, label := range current {
+		if !label.IsProjectLabel {
+			continue
+		}
+
+		want, ok := desired[label.Name]
+		if !ok {
+			log.Printf("[DEBUG] Delete GitLab label %q of project %q", label.Name, project)
+			if _, err := client.Labels.DeleteLabel(project, &gitlab.DeleteLabelOptions{Name: gitlab.String(label.Name)}, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+				return diag.FromErr(err)
+			}
+			continue
+		}
+
+		existing[label.Name] = true
+		if want.Color == label.Color && want.Description == label.Description && want.Priority == label.Priority {
+			continue
+		}
+
+		log.Printf("[DEBUG] Update GitLab label %q of project %q", label.Name, project)
+		// NOTE: go-gitlab doesn't support removing the priority of a label yet.
+		if err := updateLabel(ctx, client, "projects", project, &updateLabelOptions{
+			Name:        gitlab.String(want.Name),
+			Color:       gitlab.String(want.Color),
+			Description: gitlab.String(want.Description),
+			Priority:    labelPriorityToOption(want.Priority),
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for name, label := range desired {
+		if existing[name] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Create GitLab label %q in project %q", name, project)
+		if _, _, err := client.Labels.CreateLabel(project, &gitlab.CreateLabelOptions{
+			Name:        gitlab.String(label.Name),
+			Color:       gitlab.String(label.Color),
+			Description: gitlab.String(label.Description),
+			Priority:    labelPriorityToOption(label.Priority),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectLabelsRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] Read GitLab labels of project %q", project)
+	labels, err := listProjectLabels(ctx, client, project, false, "")
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] GitLab project %s not found, removing labels from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	projectLabels := make([]*gitlab.Label, 0, len(labels))
+	for _, label := range labels {
+		if label.IsProjectLabel {
+			projectLabels = append(projectLabels, label)
+		}
+	}
+
+	d.Set("project", project)
+	if err := d.Set("labels", flattenGitlabLabelSet(projectLabels)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectLabelsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	for _, v := range d.Get("labels").(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+
+		log.Printf("[DEBUG] Delete GitLab label %q of project %q", name, project)
+		if _, err := client.Labels.DeleteLabel(project, &gitlab.DeleteLabelOptions{Name: gitlab.String(name)}, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectLabels_basic(t *testing.T) {
+	testGroup := testutil.CreateGroups(t, 1)[0]
+	testProject := testutil.CreateProjectWithNamespace(t, testGroup.ID)
+	if _, _, err := testutil.TestGitlabClient.GroupLabels.CreateGroupLabel(testGroup.ID, &gitlab.CreateGroupLabelOptions{
+		Name:  gitlab.String("inherited"),
+		Color: gitlab.String("#0000ff"),
+	}); err != nil {
+		t.Fatalf("failed to create group label: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectLabelsDestroy,
+		Steps: []resource.TestStep{
+			// Create the project labels
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_labels" "this" {
+						project = %d
+
+						labels {
+							name     = "bug"
+							color    = "#ff0000"
+							priority = 1
+						}
+						labels {
+							name        = "feature"
+							color       = "#00ff00"
+							description = "A new feature"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_project_labels.this", "labels.#", "2"),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project_labels.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change a label, remove a label and remove a label added outside of Terraform
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Labels.CreateLabel(testProject.ID, &gitlab.CreateLabelOptions{
+						Name:  gitlab.String("unmanaged"),
+						Color: gitlab.String("#000000"),
+					}); err != nil {
+						t.Fatalf("failed to create unmanaged label: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_labels" "this" {
+						project = %d
+
+						labels {
+							name        = "bug"
+							color       = "#cc0000"
+							description = "Something is broken"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_labels.this", "labels.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_project_labels.this", "labels.*", map[string]string{
+						"name":        "bug",
+						"color":       "#cc0000",
+						"description": "Something is broken",
+						"priority":    "0",
+					}),
+				),
+			},
+			// Verify the data source
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_labels" "this" {
+						project = %d
+
+						labels {
+							name        = "bug"
+							color       = "#cc0000"
+							description = "Something is broken"
+						}
+					}
+
+					data "gitlab_project_labels" "this" {
+						project = gitlab_project_labels.this.project
+					}
+
+					data "gitlab_project_labels" "own" {
+						project                 = gitlab_project_labels.this.project
+						include_ancestor_groups = false
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_labels.this", "labels.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_project_labels.own", "labels.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_labels.own", "labels.0.name", "bug"),
+					resource.TestCheckResourceAttr("data.gitlab_project_labels.own", "labels.0.is_project_label", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectLabelsDestroy(s *terraform.State) error {
+	for _, resourceState := range s.RootModule().Resources {
+		if resourceState.Type != "gitlab_project_labels" {
+			continue
+		}
+
+		labels, _, err := testutil.TestGitlabClient.Labels.ListLabels(resourceState.Primary.ID, &gitlab.ListLabelsOptions{IncludeAncestorGroups: gitlab.Bool(false)})
+		if err != nil {
+			return err
+		}
+		if len(labels) != 0 {
+			return fmt.Errorf("project labels of project %q still exist", resourceState.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_project_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_members`" + ` resource allows to authoritatively manage all members of a project.
+
+Direct members of the project which are not declared, e.g. because they were added in the UI, are detected and removed on the next apply.
+Additions and removals show up as changed elements of the ` + "`members`" + ` set in the plan.
+
+~> This resource must not be used together with the ` + "`gitlab_project_membership`" + ` resource for the same project, because they will fight over the members.
+
+~> Make sure to declare the user used by the provider, otherwise it's removed from the project and may lose access.
+The user used by the provider is never removed when the resource is destroyed.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html)`,
+
+		CreateContext: resourceGitlabProjectMembersSet,
+		ReadContext:   resourceGitlabProjectMembersRead,
+		UpdateContext: resourceGitlabProjectMembersSet,
+		DeleteContext: resourceGitlabProjectMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: constructSchema(
+			map[string]*schema.Schema{
+				"project": {
+					Description: "The ID or URL-encoded path of the project.",
+					Type:        schema.TypeString,
+					ForceNew:    true,
+					Required:    true,
+				},
+			},
+			gitlabMembersSchema("project", api.ValidProjectAccessLevelNames),
+		),
+	}
+})
+
+func resourceGitlabProjectMembersSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] set gitlab project members of %s", project)
+	if err := gitlabMembersApply(d, resourceGitlabProjectMembersClient(ctx, client, project)); err != nil {
+		return diag.Errorf("failed to set members of project %s: %v", project, err)
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectMembersRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project members of %s", project)
+	members, err := gitlabMembersRead(d, resourceGitlabProjectMembersClient(ctx, client, project))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found so removing members from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get current user: %v", err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab project members of %s", project)
+	if err := gitlabMembersDelete(d, resourceGitlabProjectMembersClient(ctx, client, project), currentUser.ID); err != nil {
+		return diag.Errorf("failed to delete members of project %s: %v", project, err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectMembersClient(ctx context.Context, client *gitlab.Client, project string) *gitlabMembersClient {
+	list := func(listMembers func(pid interface{}, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)) ([]*gitlabMember, error) {
+		options := &gitlab.ListProjectMembersOptions{
+			ListOptions: gitlab.ListOptions{
+				PerPage: 100,
+				Page:    1,
+			},
+		}
+
+		var members []*gitlabMember
+		for options.Page != 0 {
+			projectMembers, resp, err := listMembers(project, options, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range projectMembers {
+				members = append(members, &gitlabMember{
+					UserID:      m.ID,
+					Username:    m.Username,
+					AccessLevel: m.AccessLevel,
+					ExpiresAt:   isoTimeToString(m.ExpiresAt),
+				})
+			}
+			options.Page = resp.NextPage
+		}
+		return members, nil
+	}
+
+	return &gitlabMembersClient{
+		listDirect: func() ([]*gitlabMember, error) {
+			return list(client.ProjectMembers.ListProjectMembers)
+		},
+		listAll: func() ([]*gitlabMember, error) {
+			return list(client.ProjectMembers.ListAllProjectMembers)
+		},
+		add: func(member *gitlabMember) error {
+			_, _, err := client.ProjectMembers.AddProjectMember(project, &gitlab.AddProjectMemberOptions{
+				UserID:      member.UserID,
+				AccessLevel: gitlab.AccessLevel(member.AccessLevel),
+				ExpiresAt:   gitlab.String(member.ExpiresAt),
+			}, gitlab.WithContext(ctx))
+			return err
+		},
+		edit: func(member *gitlabMember) error {
+			_, _, err := client.ProjectMembers.EditProjectMember(project, member.UserID, &gitlab.EditProjectMemberOptions{
+				AccessLevel: gitlab.AccessLevel(member.AccessLevel),
+				ExpiresAt:   gitlab.String(member.ExpiresAt),
+			}, gitlab.WithContext(ctx))
+			return err
+		},
+		remove: func(member *gitlabMember) error {
+			_, err := client.ProjectMembers.DeleteProjectMember(project, member.UserID, gitlab.WithContext(ctx))
+			return err
+		},
+	}
+}
@@ -0,0 +1,140 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectMembers_basic(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+	project := testutil.CreateProjectWithNamespace(t, group.ID)
+	users := testutil.CreateUsers(t, 3)
+	// NOTE: this member is added outside of Terraform and must be removed on apply.
+	testutil.AddProjectMembers(t, project.ID, []*gitlab.User{users[2]})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectMembersDestroy(project.ID, users),
+		Steps: []resource.TestStep{
+			// Declare the members, which removes the unmanaged member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_members" "this" {
+						project                  = "%d"
+						ignore_inherited_members = true
+
+						members {
+							user_id      = %d
+							access_level = "developer"
+						}
+					}
+				`, project.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_members.this", "members.#", "1"),
+					testAccCheckGitlabProjectMembers(project.ID, map[int]gitlab.AccessLevelValue{
+						users[0].ID: gitlab.DeveloperPermissions,
+					}),
+				),
+			},
+			// Verify import
+			// NOTE: the imported state contains the inherited members of the group, because they aren't ignored by default.
+			{
+				ResourceName:            "gitlab_project_members.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_inherited_members", "ignore_bot_members", "members"},
+			},
+			// Update an access level and add a member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_members" "this" {
+						project                  = "%d"
+						ignore_inherited_members = true
+
+						members {
+							user_id      = %d
+							access_level = "maintainer"
+						}
+
+						members {
+							user_id      = %d
+							access_level = "reporter"
+							expires_at   = "2099-01-01"
+						}
+					}
+				`, project.ID, users[0].ID, users[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_members.this", "members.#", "2"),
+					testAccCheckGitlabProjectMembers(project.ID, map[int]gitlab.AccessLevelValue{
+						users[0].ID: gitlab.MaintainerPermissions,
+						users[1].ID: gitlab.ReporterPermissions,
+					}),
+				),
+			},
+			// Remove a member
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_members" "this" {
+						project                  = "%d"
+						ignore_inherited_members = true
+
+						members {
+							user_id      = %d
+							access_level = "maintainer"
+						}
+					}
+				`, project.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_members.this", "members.#", "1"),
+					testAccCheckGitlabProjectMembers(project.ID, map[int]gitlab.AccessLevelValue{
+						users[0].ID: gitlab.MaintainerPermissions,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectMembers(projectID int, expected map[int]gitlab.AccessLevelValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, _, err := testutil.TestGitlabClient.ProjectMembers.ListProjectMembers(projectID, &gitlab.ListProjectMembersOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(members) != len(expected) {
+			return fmt.Errorf("expected %d direct members, got %d", len(expected), len(members))
+		}
+		for _, m := range members {
+			accessLevel, ok := expected[m.ID]
+			if !ok {
+				return fmt.Errorf("unexpected member %s (%d)", m.Username, m.ID)
+			}
+			if m.AccessLevel != accessLevel {
+				return fmt.Errorf("expected access level %d for member %d, got %d", accessLevel, m.ID, m.AccessLevel)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabProjectMembersDestroy(projectID int, users []*gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, user := range users {
+			_, _, err := testutil.TestGitlabClient.ProjectMembers.GetProjectMember(projectID, user.ID)
+			if err == nil {
+				return fmt.Errorf("user %d is still a member of project %d", user.ID, projectID)
+			}
+		}
+		return nil
+	}
+}
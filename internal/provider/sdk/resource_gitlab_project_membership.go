@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -69,6 +70,11 @@ func gitlabProjectMembershipSchemaV1() map[string]*schema.Schema {
 			ValidateFunc: validateDateFunc,
 			Optional:     true,
 		},
+		"member_role_id": {
+			Description: "The ID of a custom member role, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
 	}
 }
 
@@ -125,17 +131,26 @@ func resourceGitlabProjectMembershipCreate(ctx context.Context, d *schema.Resour
 	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := api.AccessLevelNameToValue[d.Get("access_level").(string)]
 
-	options := &gitlab.AddProjectMemberOptions{
-		UserID:      &userId,
-		AccessLevel: &accessLevelId,
-		ExpiresAt:   &expiresAt,
+	options := &addProjectMemberOptions{
+		AddProjectMemberOptions: gitlab.AddProjectMemberOptions{
+			UserID:      &userId,
+			AccessLevel: &accessLevelId,
+			ExpiresAt:   &expiresAt,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	log.Printf("[DEBUG] create gitlab project membership for %d in %s", options.UserID, project)
 
-	_, _, err := client.ProjectMembers.AddProjectMember(project, options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the `member_role_id` attribute yet.
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/members", gitlab.PathEscape(project)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 	userIdString := strconv.Itoa(userId)
 	d.SetId(utils.BuildTwoPartID(&project, &userIdString))
 	return resourceGitlabProjectMembershipRead(ctx, d, meta)
@@ -151,8 +166,12 @@ func resourceGitlabProjectMembershipRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	projectMember, _, err := client.ProjectMembers.GetProjectMember(project, userId, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/members/%d", gitlab.PathEscape(project), userId), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
+		return diag.FromErr(err)
+	}
+	var projectMember projectMemberWithRole
+	if _, err := client.Do(req, &projectMember); err != nil {
 		if api.Is404(err) {
 			log.Printf("[DEBUG] gitlab project membership for %s not found so removing from state", d.Id())
 			d.SetId("")
@@ -161,7 +180,7 @@ func resourceGitlabProjectMembershipRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	resourceGitlabProjectMembershipSetToState(d, projectMember, &project)
+	resourceGitlabProjectMembershipSetToState(d, &projectMember, &project)
 	return nil
 }
 
@@ -185,16 +204,24 @@ func resourceGitlabProjectMembershipUpdate(ctx context.Context, d *schema.Resour
 	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := api.AccessLevelNameToValue[strings.ToLower(d.Get("access_level").(string))]
 
-	options := gitlab.EditProjectMemberOptions{
-		AccessLevel: &accessLevelId,
-		ExpiresAt:   &expiresAt,
+	options := editProjectMemberOptions{
+		EditProjectMemberOptions: gitlab.EditProjectMemberOptions{
+			AccessLevel: &accessLevelId,
+			ExpiresAt:   &expiresAt,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	log.Printf("[DEBUG] update gitlab project membership %v for %s", userId, project)
 
-	_, _, err := client.ProjectMembers.EditProjectMember(project, userId, &options, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s/members/%d", gitlab.PathEscape(project), userId), &options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceGitlabProjectMembershipRead(ctx, d, meta)
 }
 
@@ -217,7 +244,7 @@ func resourceGitlabProjectMembershipDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
-func resourceGitlabProjectMembershipSetToState(d *schema.ResourceData, projectMember *gitlab.ProjectMember, projectId *string) {
+func resourceGitlabProjectMembershipSetToState(d *schema.ResourceData, projectMember *projectMemberWithRole, projectId *string) {
 
 	d.Set("project", projectId)
 	d.Set("user_id", projectMember.ID)
@@ -227,6 +254,28 @@ func resourceGitlabProjectMembershipSetToState(d *schema.ResourceData, projectMe
 	} else {
 		d.Set("expires_at", "")
 	}
+	if projectMember.MemberRole != nil {
+		d.Set("member_role_id", projectMember.MemberRole.ID)
+	} else {
+		d.Set("member_role_id", 0)
+	}
 	userId := strconv.Itoa(projectMember.ID)
 	d.SetId(utils.BuildTwoPartID(projectId, &userId))
 }
+
+// projectMemberWithRole is a project member including the custom member role, which is not yet available in go-gitlab.
+type projectMemberWithRole struct {
+	gitlab.ProjectMember
+	MemberRole *api.MemberRoleID `json:"member_role"`
+}
+
+type addProjectMemberOptions struct {
+	gitlab.AddProjectMemberOptions
+	MemberRoleID *int `json:"member_role_id,omitempty"`
+}
+
+// editProjectMemberOptions always sends the `member_role_id`, so that removing it from the configuration removes the custom member role.
+type editProjectMemberOptions struct {
+	gitlab.EditProjectMemberOptions
+	MemberRoleID *int `json:"member_role_id"`
+}
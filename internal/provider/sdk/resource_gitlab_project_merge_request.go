@@ -0,0 +1,475 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_project_merge_request", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_merge_request`" + ` resource allows to commit a set of file changes to a new source branch
+and to open a merge request for it, e.g. to propose generated configuration instead of committing it straight to a protected branch.
+
+-> The ` + "`files`" + ` are committed to the source branch, one commit per changed file, whenever they change while the merge request is open.
+   Changing the ` + "`files`" + ` of a merged or closed merge request opens a new merge request.
+   Files removed from ` + "`files`" + ` are not removed from the source branch.
+
+-> When ` + "`wait_for_merge`" + ` is enabled, the resource waits until the merge request is merged during create,
+   so that dependent resources are only created afterwards. The time to wait is limited by the ` + "`create`" + ` timeout.
+
+~> Destroying the resource closes the merge request if it is still open. The source branch is only deleted as well
+   if it was created by the resource, see ` + "`source_branch_created`" + `. A merged merge request is only removed from the state.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_requests.html)`,
+
+		CreateContext: resourceGitlabProjectMergeRequestCreate,
+		ReadContext:   resourceGitlabProjectMergeRequestRead,
+		UpdateContext: resourceGitlabProjectMergeRequestUpdate,
+		DeleteContext: resourceGitlabProjectMergeRequestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customdiff.ForceNewIf("files", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.Id() != "" && d.Get("state").(string) != "opened"
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"iid": {
+				Description: "The internal ID of the merge request in the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"source_branch": {
+				Description:      "The source branch of the merge request. It is created from the `target_branch` if it doesn't exist yet.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			"source_branch_created": {
+				Description: "Whether the source branch was created by the resource. Only a created source branch is deleted when the resource is destroyed while the merge request is open.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"target_branch": {
+				Description: "The target branch of the merge request. Defaults to the default branch of the project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the merge request.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the merge request.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"labels": {
+				Description: "The labels of the merge request.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"reviewer_ids": {
+				Description: "The IDs of the users to review the merge request.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Optional:    true,
+			},
+			"remove_source_branch": {
+				Description: "Remove the source branch when the merge request is merged.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"merge_when_pipeline_succeeds": {
+				Description: "Merge the merge request when the pipeline succeeds. Only applied while the merge request is open.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"wait_for_merge": {
+				Description: "Wait for the merge request to be merged during create. Usually combined with `merge_when_pipeline_succeeds`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"files": {
+				Description: "The files to commit to the source branch.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_path": {
+							Description: "The full path of the file, relative to the root of the repository.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"content": {
+							Description: "The plain text content of the file.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"commit_message": {
+				Description: "The message of the commit to the source branch.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"author_email": {
+				Description: "The email of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"author_name": {
+				Description: "The name of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"state": {
+				Description: "The state of the merge request, e.g. `opened`, `merged` or `closed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"detailed_merge_status": {
+				Description: "The detailed merge status of the merge request.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sha": {
+				Description: "The SHA of the head commit of the source branch.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"merge_commit_sha": {
+				Description: "The SHA of the merge commit, once the merge request is merged.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "The web URL of the merge request.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectMergeRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	sourceBranch := d.Get("source_branch").(string)
+
+	targetBranch := d.Get("target_branch").(string)
+	if targetBranch == "" {
+		p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.Errorf("failed to get default branch of project %q: %v", project, err)
+		}
+		targetBranch = p.DefaultBranch
+	}
+
+	sourceBranchCreated := false
+	if _, _, err := client.Branches.GetBranch(project, sourceBranch, gitlab.WithContext(ctx)); err != nil {
+		if !api.Is404(err) {
+			return diag.Errorf("failed to get branch %q of project %q: %v", sourceBranch, project, err)
+		}
+		sourceBranchCreated = true
+	}
+
+	log.Printf("[DEBUG] commit files to branch %q of project %q", sourceBranch, project)
+	if err := resourceGitlabProjectMergeRequestCommitFiles(ctx, client, d, targetBranch, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("failed to commit files to branch %q of project %q: %v", sourceBranch, project, err)
+	}
+
+	options := &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.String(d.Get("title").(string)),
+		Description:        gitlab.String(d.Get("description").(string)),
+		SourceBranch:       gitlab.String(sourceBranch),
+		TargetBranch:       gitlab.String(targetBranch),
+		Labels:             (*gitlab.Labels)(stringSetToStringSlice(d.Get("labels").(*schema.Set))),
+		ReviewerIDs:        intSetToIntSlice(d.Get("reviewer_ids").(*schema.Set)),
+		RemoveSourceBranch: gitlab.Bool(d.Get("remove_source_branch").(bool)),
+	}
+
+	log.Printf("[DEBUG] create merge request from branch %q to %q in project %q", sourceBranch, targetBranch, project)
+	mr, _, err := client.MergeRequests.CreateMergeRequest(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create merge request from branch %q in project %q: %v", sourceBranch, project, err)
+	}
+
+	iid := strconv.Itoa(mr.IID)
+	d.SetId(utils.BuildTwoPartID(&project, &iid))
+	d.Set("source_branch_created", sourceBranchCreated)
+
+	if d.Get("merge_when_pipeline_succeeds").(bool) {
+		if err := resourceGitlabProjectMergeRequestMergeWhenPipelineSucceeds(ctx, client, project, mr.IID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_merge").(bool) {
+		if err := resourceGitlabProjectMergeRequestWaitForMerge(ctx, client, project, mr.IID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabProjectMergeRequestRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectMergeRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := resourceGitlabProjectMergeRequestParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read merge request %d of project %q", iid, project)
+	mr, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] merge request %d of project %q not found, removing from state", iid, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	reviewerIDs := make([]int, 0, len(mr.Reviewers))
+	for _, reviewer := range mr.Reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.ID)
+	}
+
+	d.Set("project", project)
+	d.Set("iid", mr.IID)
+	d.Set("source_branch", mr.SourceBranch)
+	d.Set("target_branch", mr.TargetBranch)
+	d.Set("title", mr.Title)
+	d.Set("description", mr.Description)
+	if err := d.Set("labels", mr.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("reviewer_ids", reviewerIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("remove_source_branch", mr.ForceRemoveSourceBranch)
+	// NOTE: GitLab resets `merge_when_pipeline_succeeds` once the merge request is merged or closed,
+	//       therefore, we keep the configured value to not try to accept a merge request which isn't open anymore.
+	if mr.State == "opened" {
+		d.Set("merge_when_pipeline_succeeds", mr.MergeWhenPipelineSucceeds)
+	}
+	d.Set("state", mr.State)
+	d.Set("detailed_merge_status", mr.DetailedMergeStatus)
+	d.Set("sha", mr.SHA)
+	d.Set("merge_commit_sha", mr.MergeCommitSHA)
+	d.Set("web_url", mr.WebURL)
+	return nil
+}
+
+func resourceGitlabProjectMergeRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := resourceGitlabProjectMergeRequestParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("files") {
+		log.Printf("[DEBUG] commit changed files to branch %q of project %q", d.Get("source_branch").(string), project)
+		if err := resourceGitlabProjectMergeRequestCommitFiles(ctx, client, d, d.Get("target_branch").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("failed to commit files to branch %q of project %q: %v", d.Get("source_branch").(string), project, err)
+		}
+	}
+
+	options := &gitlab.UpdateMergeRequestOptions{}
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("target_branch") {
+		options.TargetBranch = gitlab.String(d.Get("target_branch").(string))
+	}
+	if d.HasChange("labels") {
+		options.Labels = (*gitlab.Labels)(stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+	}
+	if d.HasChange("reviewer_ids") {
+		options.ReviewerIDs = intSetToIntSlice(d.Get("reviewer_ids").(*schema.Set))
+	}
+	if d.HasChange("remove_source_branch") {
+		options.RemoveSourceBranch = gitlab.Bool(d.Get("remove_source_branch").(bool))
+	}
+
+	if *options != (gitlab.UpdateMergeRequestOptions{}) {
+		log.Printf("[DEBUG] update merge request %d of project %q", iid, project)
+		if _, _, err := client.MergeRequests.UpdateMergeRequest(project, iid, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update merge request %d of project %q: %v", iid, project, err)
+		}
+	}
+
+	if d.HasChange("merge_when_pipeline_succeeds") && d.Get("state").(string) == "opened" {
+		if d.Get("merge_when_pipeline_succeeds").(bool) {
+			if err := resourceGitlabProjectMergeRequestMergeWhenPipelineSucceeds(ctx, client, project, iid, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("[DEBUG] cancel merge when pipeline succeeds of merge request %d of project %q", iid, project)
+			if _, _, err := client.MergeRequests.CancelMergeWhenPipelineSucceeds(project, iid, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("failed to cancel merge when pipeline succeeds of merge request %d of project %q: %v", iid, project, err)
+			}
+		}
+	}
+
+	return resourceGitlabProjectMergeRequestRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectMergeRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := resourceGitlabProjectMergeRequestParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mr, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if mr.State != "opened" {
+		log.Printf("[DEBUG] merge request %d of project %q is %s, only removing it from state", iid, project, mr.State)
+		return nil
+	}
+
+	log.Printf("[DEBUG] close merge request %d of project %q", iid, project)
+	if _, _, err := client.MergeRequests.UpdateMergeRequest(project, iid, &gitlab.UpdateMergeRequestOptions{StateEvent: gitlab.String("close")}, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("failed to close merge request %d of project %q: %v", iid, project, err)
+	}
+
+	if !d.Get("source_branch_created").(bool) {
+		log.Printf("[DEBUG] source branch %q of merge request %d of project %q wasn't created by the resource, keeping it", mr.SourceBranch, iid, project)
+		return nil
+	}
+
+	log.Printf("[DEBUG] delete source branch %q of merge request %d of project %q", mr.SourceBranch, iid, project)
+	if _, err := client.Branches.DeleteBranch(project, mr.SourceBranch, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.Errorf("failed to delete source branch %q of project %q: %v", mr.SourceBranch, project, err)
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectMergeRequestCommitFiles commits the configured files to the source branch,
+// which is created from the given target branch if it doesn't exist yet.
+func resourceGitlabProjectMergeRequestCommitFiles(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, targetBranch string, timeout time.Duration) error {
+	files := make(map[string]string)
+	for _, f := range d.Get("files").(*schema.Set).List() {
+		file := f.(map[string]interface{})
+		files[file["file_path"].(string)] = file["content"].(string)
+	}
+
+	return api.CommitRepositoryFiles(ctx, client, d.Get("project").(string), files, &api.RepositoryFileCommitOptions{
+		Branch:        d.Get("source_branch").(string),
+		StartBranch:   targetBranch,
+		CommitMessage: d.Get("commit_message").(string),
+		AuthorEmail:   d.Get("author_email").(string),
+		AuthorName:    d.Get("author_name").(string),
+		Timeout:       timeout,
+	})
+}
+
+// resourceGitlabProjectMergeRequestMergeWhenPipelineSucceeds sets the merge request to be merged when the pipeline succeeds,
+// once GitLab finished checking if it can be merged.
+func resourceGitlabProjectMergeRequestMergeWhenPipelineSucceeds(ctx context.Context, client *gitlab.Client, project string, iid int, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"unchecked", "checking", "preparing", "approvals_syncing"},
+		Target:  []string{"ready"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			mr, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+			switch mr.DetailedMergeStatus {
+			case "unchecked", "checking", "preparing", "approvals_syncing":
+				return mr, mr.DetailedMergeStatus, nil
+			}
+			return mr, "ready", nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for merge request %d of project %q to be checked: %w", iid, project, err)
+	}
+
+	log.Printf("[DEBUG] set merge request %d of project %q to merge when pipeline succeeds", iid, project)
+	if _, _, err := client.MergeRequests.AcceptMergeRequest(project, iid, &gitlab.AcceptMergeRequestOptions{
+		MergeWhenPipelineSucceeds: gitlab.Bool(true),
+	}, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to set merge request %d of project %q to merge when pipeline succeeds: %w", iid, project, err)
+	}
+	return nil
+}
+
+// resourceGitlabProjectMergeRequestWaitForMerge waits until the merge request is merged.
+func resourceGitlabProjectMergeRequestWaitForMerge(ctx context.Context, client *gitlab.Client, project string, iid int, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"opened", "locked"},
+		Target:  []string{"merged"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			mr, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+			if mr.State == "closed" {
+				return mr, mr.State, fmt.Errorf("merge request was closed without being merged")
+			}
+			return mr, mr.State, nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for merge request %d of project %q to be merged: %w", iid, project, err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectMergeRequestParseID(id string) (string, int, error) {
+	project, rawIID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	iid, err := strconv.Atoi(rawIID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to parse merge request IID %q: %w", rawIID, err)
+	}
+	return project, iid, nil
+}
@@ -0,0 +1,224 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectMergeRequest_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testUser := testutil.CreateUsers(t, 1)[0]
+	testutil.AddProjectMembers(t, testProject.ID, []*gitlab.User{testUser})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectMergeRequestDestroy,
+		Steps: []resource.TestStep{
+			// Create a merge request with a new file
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_merge_request" "this" {
+						project        = %d
+						source_branch  = "update-codeowners"
+						title          = "Update CODEOWNERS"
+						labels         = ["automation"]
+						commit_message = "Update CODEOWNERS"
+
+						files {
+							file_path = "CODEOWNERS"
+							content   = "* @root\n"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "state", "opened"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "target_branch", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "labels.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "source_branch_created", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_project_merge_request.this", "iid"),
+					resource.TestCheckResourceAttrSet("gitlab_project_merge_request.this", "web_url"),
+					func(_ *terraform.State) error {
+						content, _, err := api.GetRepositoryFileContent(context.Background(), testutil.TestGitlabClient, fmt.Sprintf("%d", testProject.ID), "CODEOWNERS", "update-codeowners")
+						if err != nil {
+							return err
+						}
+						if content != "* @root\n" {
+							return fmt.Errorf("unexpected content of CODEOWNERS: %q", content)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_merge_request.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"files", "commit_message", "source_branch_created"},
+			},
+			// Update the merge request and the files in-place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_merge_request" "this" {
+						project        = %d
+						source_branch  = "update-codeowners"
+						title          = "Update CODEOWNERS and README"
+						description    = "Generated by Terraform"
+						reviewer_ids   = [%d]
+						commit_message = "Update CODEOWNERS and README"
+
+						files {
+							file_path = "CODEOWNERS"
+							content   = "* @root @%s\n"
+						}
+						files {
+							file_path = "README.md"
+							content   = "# Generated by Terraform\n"
+						}
+					}
+				`, testProject.ID, testUser.ID, testUser.Username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "state", "opened"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "title", "Update CODEOWNERS and README"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "labels.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "reviewer_ids.#", "1"),
+					func(_ *terraform.State) error {
+						content, _, err := api.GetRepositoryFileContent(context.Background(), testutil.TestGitlabClient, fmt.Sprintf("%d", testProject.ID), "README.md", "update-codeowners")
+						if err != nil {
+							return err
+						}
+						if content != "# Generated by Terraform\n" {
+							return fmt.Errorf("unexpected content of README.md: %q", content)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_merge_request.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"files", "commit_message", "source_branch_created"},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectMergeRequest_mergeWhenPipelineSucceeds(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	config := fmt.Sprintf(`
+		resource "gitlab_project_merge_request" "this" {
+			project                      = %d
+			source_branch                = "add-readme"
+			title                        = "Add README"
+			commit_message               = "Add README"
+			remove_source_branch         = true
+			merge_when_pipeline_succeeds = true
+			wait_for_merge               = true
+
+			files {
+				file_path = "README.md"
+				content   = "# Generated by Terraform\n"
+			}
+		}
+	`, testProject.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectMergeRequestDestroy,
+		Steps: []resource.TestStep{
+			// Create a merge request which is merged right away, because the project has no pipeline
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "state", "merged"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "merge_when_pipeline_succeeds", "true"),
+				),
+			},
+			// Verify that the merged merge request doesn't produce a diff
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectMergeRequest_existingSourceBranch(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+	testBranch := testutil.CreateBranches(t, testProject, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectMergeRequestDestroy,
+		Steps: []resource.TestStep{
+			// Create a merge request from an existing branch, which is kept on destroy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_merge_request" "this" {
+						project        = %d
+						source_branch  = "%s"
+						title          = "Update CODEOWNERS"
+						commit_message = "Update CODEOWNERS"
+
+						files {
+							file_path = "CODEOWNERS"
+							content   = "* @root\n"
+						}
+					}
+				`, testProject.ID, testBranch.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "state", "opened"),
+					resource.TestCheckResourceAttr("gitlab_project_merge_request.this", "source_branch_created", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectMergeRequestDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_merge_request" {
+			continue
+		}
+
+		project, iid, err := resourceGitlabProjectMergeRequestParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		mr, _, err := testutil.TestGitlabClient.MergeRequests.GetMergeRequest(project, iid, nil)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+		if mr.State == "opened" {
+			return fmt.Errorf("merge request %d of project %q is still open", iid, project)
+		}
+
+		_, _, err = testutil.TestGitlabClient.Branches.GetBranch(project, mr.SourceBranch)
+		if rs.Primary.Attributes["source_branch_created"] == "true" {
+			if !api.Is404(err) {
+				return fmt.Errorf("source branch %q of project %q still exists", mr.SourceBranch, project)
+			}
+		} else if err != nil {
+			return fmt.Errorf("source branch %q of project %q wasn't kept: %v", mr.SourceBranch, project, err)
+		}
+	}
+	return nil
+}
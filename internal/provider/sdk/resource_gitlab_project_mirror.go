@@ -2,13 +2,17 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 
@@ -19,8 +23,8 @@ var _ = registerResource("gitlab_project_mirror", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_project_mirror` + "`" + ` resource allows to manage the lifecycle of a project mirror.
 
-This is for *pushing* changes to a remote repository. *Pull Mirroring* can be configured using a combination of the
-import_url, mirror, and mirror_trigger_builds properties on the gitlab_project resource.
+This is for *pushing* changes to a remote repository. *Pull Mirroring* can be configured using the ` + "`" + `gitlab_project_pull_mirror` + "`" + ` resource
+or a combination of the import_url, mirror, and mirror_trigger_builds properties on the gitlab_project resource.
 
 -> **Destroy Behavior** GitLab 14.10 introduced an API endpoint to delete a project mirror.
    Therefore, for GitLab 14.10 and newer the project mirror will be destroyed when the resource is destroyed.
@@ -49,28 +53,12 @@ import_url, mirror, and mirror_trigger_builds properties on the gitlab_project r
 				Computed:    true,
 			},
 			"url": {
-				Description: "The URL of the remote repository to be mirrored.",
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Sensitive:   true, // Username and password must be provided in the URL for https.
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					oldURL, err := url.Parse(old)
-					if err != nil {
-						return old == new
-					}
-					newURL, err := url.Parse(new)
-					if err != nil {
-						return old == new
-					}
-					if oldURL.User != nil {
-						oldURL.User = url.UserPassword("redacted", "redacted")
-					}
-					if newURL.User != nil {
-						newURL.User = url.UserPassword("redacted", "redacted")
-					}
-					return oldURL.String() == newURL.String()
-				},
+				Description:      "The URL of the remote repository to be mirrored.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				Sensitive:        true, // Username and password must be provided in the URL for https.
+				DiffSuppressFunc: mirrorURLDiffSuppressFunc,
 			},
 			"enabled": {
 				Description: "Determines if the mirror is enabled.",
@@ -90,10 +78,75 @@ import_url, mirror, and mirror_trigger_builds properties on the gitlab_project r
 				Optional:    true,
 				Default:     true,
 			},
+			"auth_method": {
+				Description:      fmt.Sprintf("The authentication method of the mirror. Valid values are: %s. For `ssh_public_key`, GitLab generates an SSH key pair and the public key is exposed in `ssh_public_key`.", utils.RenderValueListForDocs(validProjectMirrorAuthMethods)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validProjectMirrorAuthMethods, false)),
+			},
+			"mirror_branch_regex": {
+				Description:      "Only mirror branches which match the regular expression. Requires `only_protected_branches` to be `false`. Requires GitLab Premium.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"ssh_public_key": {
+				Description: "The SSH public key GitLab uses to authenticate with the remote repository if `auth_method` is `ssh_public_key`. Add it as a deploy key with write access to the remote repository. Requires GitLab 17.9 or later.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"update_status": {
+				Description: "The status of the last update of the mirror, e.g. `finished` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_error": {
+				Description: "The error of the last failed update of the mirror.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_update_at": {
+				Description: "The time of the last update of the mirror, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_successful_update_at": {
+				Description: "The time of the last successful update of the mirror, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Get("mirror_branch_regex").(string) != "" && d.Get("only_protected_branches").(bool) {
+				return fmt.Errorf("`only_protected_branches` must be `false` if `mirror_branch_regex` is set")
+			}
+			return nil
 		},
 	}
 })
 
+var validProjectMirrorAuthMethods = []string{"password", "ssh_public_key"}
+
+// projectMirror is a push mirror including the attributes which are not yet available in go-gitlab.
+type projectMirror struct {
+	gitlab.ProjectMirror
+	AuthMethod        string  `json:"auth_method"`
+	MirrorBranchRegex *string `json:"mirror_branch_regex"`
+}
+
+type addProjectMirrorOptions struct {
+	gitlab.AddProjectMirrorOptions
+	AuthMethod        *string `json:"auth_method,omitempty"`
+	MirrorBranchRegex *string `json:"mirror_branch_regex,omitempty"`
+}
+
+type editProjectMirrorOptions struct {
+	gitlab.EditProjectMirrorOptions
+	AuthMethod        *string `json:"auth_method,omitempty"`
+	MirrorBranchRegex *string `json:"mirror_branch_regex,omitempty"`
+}
+
 func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 
@@ -103,19 +156,32 @@ func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceDa
 	onlyProtectedBranches := d.Get("only_protected_branches").(bool)
 	keepDivergentRefs := d.Get("keep_divergent_refs").(bool)
 
-	options := &gitlab.AddProjectMirrorOptions{
-		URL:                   &URL,
-		Enabled:               &enabled,
-		OnlyProtectedBranches: &onlyProtectedBranches,
-		KeepDivergentRefs:     &keepDivergentRefs,
+	options := &addProjectMirrorOptions{
+		AddProjectMirrorOptions: gitlab.AddProjectMirrorOptions{
+			URL:                   &URL,
+			Enabled:               &enabled,
+			OnlyProtectedBranches: &onlyProtectedBranches,
+			KeepDivergentRefs:     &keepDivergentRefs,
+		},
+	}
+	if v, ok := d.GetOk("auth_method"); ok {
+		options.AuthMethod = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("mirror_branch_regex"); ok {
+		options.MirrorBranchRegex = gitlab.String(v.(string))
 	}
 
 	log.Printf("[DEBUG] create gitlab project mirror for project %v", projectID)
 
-	mirror, _, err := client.ProjectMirrors.AddProjectMirror(projectID, options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the auth method and the branch regex yet.
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/remote_mirrors", gitlab.PathEscape(projectID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	var mirror projectMirror
+	if _, err := client.Do(req, &mirror); err != nil {
+		return diag.FromErr(err)
+	}
 	d.Set("mirror_id", mirror.ID)
 
 	mirrorID := strconv.Itoa(mirror.ID)
@@ -132,17 +198,29 @@ func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceDa
 	onlyProtectedBranches := d.Get("only_protected_branches").(bool)
 	keepDivergentRefs := d.Get("keep_divergent_refs").(bool)
 
-	options := gitlab.EditProjectMirrorOptions{
-		Enabled:               &enabled,
-		OnlyProtectedBranches: &onlyProtectedBranches,
-		KeepDivergentRefs:     &keepDivergentRefs,
+	options := &editProjectMirrorOptions{
+		EditProjectMirrorOptions: gitlab.EditProjectMirrorOptions{
+			Enabled:               &enabled,
+			OnlyProtectedBranches: &onlyProtectedBranches,
+			KeepDivergentRefs:     &keepDivergentRefs,
+		},
+	}
+	if d.HasChange("auth_method") {
+		options.AuthMethod = gitlab.String(d.Get("auth_method").(string))
+	}
+	if d.HasChange("mirror_branch_regex") {
+		options.MirrorBranchRegex = gitlab.String(d.Get("mirror_branch_regex").(string))
 	}
 	log.Printf("[DEBUG] update gitlab project mirror %v for %s", mirrorID, projectID)
 
-	_, _, err := client.ProjectMirrors.EditProjectMirror(projectID, mirrorID, &options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the auth method and the branch regex yet.
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s/remote_mirrors/%d", gitlab.PathEscape(projectID), mirrorID), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceGitlabProjectMirrorRead(ctx, d, meta)
 }
 
@@ -200,30 +278,85 @@ func resourceGitlabProjectMirrorRead(ctx context.Context, d *schema.ResourceData
 		return nil
 	}
 
+	sshPublicKey := ""
+	if mirror.AuthMethod == "ssh_public_key" {
+		sshPublicKey, err = resourceGitLabProjectMirrorGetPublicKey(ctx, client, projectID, mirrorID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourceGitlabProjectMirrorSetToState(d, mirror, &projectID)
+	d.Set("ssh_public_key", sshPublicKey)
 	return nil
 }
 
-func resourceGitlabProjectMirrorSetToState(d *schema.ResourceData, projectMirror *gitlab.ProjectMirror, projectID *string) {
+func resourceGitlabProjectMirrorSetToState(d *schema.ResourceData, projectMirror *projectMirror, projectID *string) {
 	d.Set("enabled", projectMirror.Enabled)
 	d.Set("mirror_id", projectMirror.ID)
 	d.Set("keep_divergent_refs", projectMirror.KeepDivergentRefs)
 	d.Set("only_protected_branches", projectMirror.OnlyProtectedBranches)
 	d.Set("project", projectID)
 	d.Set("url", projectMirror.URL)
+	// NOTE: older GitLab versions don't return the auth method.
+	if projectMirror.AuthMethod != "" {
+		d.Set("auth_method", projectMirror.AuthMethod)
+	}
+	mirrorBranchRegex := ""
+	if projectMirror.MirrorBranchRegex != nil {
+		mirrorBranchRegex = *projectMirror.MirrorBranchRegex
+	}
+	d.Set("mirror_branch_regex", mirrorBranchRegex)
+	d.Set("update_status", projectMirror.UpdateStatus)
+	d.Set("last_error", projectMirror.LastError)
+	lastUpdateAt := ""
+	if projectMirror.LastUpdateAt != nil {
+		lastUpdateAt = projectMirror.LastUpdateAt.Format(time.RFC3339)
+	}
+	d.Set("last_update_at", lastUpdateAt)
+	lastSuccessfulUpdateAt := ""
+	if projectMirror.LastSuccessfulUpdateAt != nil {
+		lastSuccessfulUpdateAt = projectMirror.LastSuccessfulUpdateAt.Format(time.RFC3339)
+	}
+	d.Set("last_successful_update_at", lastSuccessfulUpdateAt)
+}
+
+// resourceGitLabProjectMirrorGetPublicKey returns the SSH public key of a mirror using SSH public key authentication.
+// GitLab versions without the public key endpoint return an empty key.
+//
+// NOTE: go-gitlab doesn't support the public key endpoint yet.
+func resourceGitLabProjectMirrorGetPublicKey(ctx context.Context, client *gitlab.Client, projectID string, mirrorID int) (string, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/remote_mirrors/%d/public_key", gitlab.PathEscape(projectID), mirrorID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return "", err
+	}
+	var publicKey struct {
+		PublicKey string `json:"public_key"`
+	}
+	if _, err := client.Do(req, &publicKey); err != nil {
+		if api.Is404(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return publicKey.PublicKey, nil
 }
 
-func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Client, projectID string, mirrorID int) (*gitlab.ProjectMirror, error) {
+func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Client, projectID string, mirrorID int) (*projectMirror, error) {
 	isGetProjectMirrorSupported, err := api.IsGitLabVersionAtLeast(ctx, client, "14.10")()
 	if err != nil {
 		return nil, err
 	}
 
-	var mirror *gitlab.ProjectMirror
+	var mirror *projectMirror
 
 	if isGetProjectMirrorSupported {
-		mirror, _, err = client.ProjectMirrors.GetProjectMirror(projectID, mirrorID, gitlab.WithContext(ctx))
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/remote_mirrors/%d", gitlab.PathEscape(projectID), mirrorID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 		if err != nil {
+			return nil, err
+		}
+		mirror = &projectMirror{}
+		if _, err := client.Do(req, mirror); err != nil {
 			if api.Is404(err) {
 				return nil, nil
 			}
@@ -245,7 +378,7 @@ func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Cl
 
 			for _, m := range mirrors {
 				if m.ID == mirrorID {
-					mirror = m
+					mirror = &projectMirror{ProjectMirror: *m}
 					found = true
 					break
 				}
@@ -256,3 +389,23 @@ func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Cl
 
 	return mirror, nil
 }
+
+// mirrorURLDiffSuppressFunc ignores differences in the credentials of a mirror URL,
+// because GitLab never returns them.
+func mirrorURLDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldURL, err := url.Parse(old)
+	if err != nil {
+		return old == new
+	}
+	newURL, err := url.Parse(new)
+	if err != nil {
+		return old == new
+	}
+	if oldURL.User != nil {
+		oldURL.User = url.UserPassword("redacted", "redacted")
+	}
+	if newURL.User != nil {
+		newURL.User = url.UserPassword("redacted", "redacted")
+	}
+	return oldURL.String() == newURL.String()
+}
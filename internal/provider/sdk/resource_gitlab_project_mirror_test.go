@@ -6,6 +6,7 @@ package sdk
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -71,6 +72,70 @@ func TestAccGitlabProjectMirror_basic(t *testing.T) {
 				ResourceName:      "gitlab_project_mirror.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The update status changes while GitLab tries to update the mirror.
+				ImportStateVerifyIgnore: []string{"update_status", "last_error", "last_update_at", "last_successful_update_at"},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectMirror_sshPublicKey(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "17.9")
+
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectMirrorDestroy,
+		Steps: []resource.TestStep{
+			// Create a mirror with SSH public key authentication and a branch regex
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_mirror" "foo" {
+						project                 = "%s"
+						url                     = "ssh://git@example.com/mirror.git"
+						auth_method             = "ssh_public_key"
+						only_protected_branches = false
+						mirror_branch_regex     = "^release/.*$"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_mirror.foo", "auth_method", "ssh_public_key"),
+					resource.TestCheckResourceAttr("gitlab_project_mirror.foo", "mirror_branch_regex", "^release/.*$"),
+					resource.TestMatchResourceAttr("gitlab_project_mirror.foo", "ssh_public_key", regexp.MustCompile(`^ssh-`)),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_mirror.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_status", "last_error", "last_update_at", "last_successful_update_at"},
+			},
+			// Remove the branch regex
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_mirror" "foo" {
+						project                 = "%s"
+						url                     = "ssh://git@example.com/mirror.git"
+						auth_method             = "ssh_public_key"
+						only_protected_branches = false
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("gitlab_project_mirror.foo", "mirror_branch_regex", ""),
+			},
+			// The branch regex requires to mirror all branches
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_mirror" "foo" {
+						project             = "%s"
+						url                 = "ssh://git@example.com/mirror.git"
+						auth_method         = "ssh_public_key"
+						mirror_branch_regex = "^release/.*$"
+					}
+				`, testProject.PathWithNamespace),
+				ExpectError: regexp.MustCompile("`only_protected_branches` must be `false` if `mirror_branch_regex` is set"),
 			},
 		},
 	})
@@ -0,0 +1,289 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_project_pull_mirror", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_pull_mirror`" + ` resource allows to manage the pull mirror of a project.
+
+This is for *pulling* changes from a remote repository. *Push Mirroring* can be configured using the ` + "`gitlab_project_mirror`" + ` resource.
+
+~> This resource must not be used together with the ` + "`import_url`" + `, ` + "`mirror`" + `, ` + "`mirror_trigger_builds`" + `, ` + "`mirror_overwrites_diverged_branches`" + `
+   and ` + "`only_mirror_protected_branches`" + ` attributes of the ` + "`gitlab_project`" + ` resource for the same project.
+
+-> The credentials are never returned by GitLab, therefore the provider cannot detect configuration drift in the credentials.
+   SSH authentication and host key verification for pull mirrors can't be configured with the GitLab API and must be set up in the GitLab UI.
+
+-> **Destroy Behavior** Destroying the resource disables the pull mirror.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#configure-pull-mirroring-for-a-project)`,
+
+		CreateContext: resourceGitlabProjectPullMirrorCreate,
+		ReadContext:   resourceGitlabProjectPullMirrorRead,
+		UpdateContext: resourceGitlabProjectPullMirrorUpdate,
+		DeleteContext: resourceGitlabProjectPullMirrorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"url": {
+				Description:      "The URL of the remote repository to pull from. Provide the credentials in `auth_user` and `auth_password`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: mirrorURLDiffSuppressFunc,
+			},
+			"auth_user": {
+				Description:  "The username used to authenticate with the remote repository. This field cannot be imported using `terraform import`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"auth_password"},
+			},
+			"auth_password": {
+				Description:  "The password or token used to authenticate with the remote repository. This field cannot be imported using `terraform import`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"auth_user"},
+			},
+			"enabled": {
+				Description: "Determines if the pull mirror is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"mirror_trigger_builds": {
+				Description: "Determines if pipelines are triggered for mirror updates.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"mirror_overwrites_diverged_branches": {
+				Description: "Determines if diverged branches are overwritten.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"only_mirror_protected_branches": {
+				Description:   "Determines if only protected branches are mirrored.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"mirror_branch_regex"},
+			},
+			"mirror_branch_regex": {
+				Description:      "Only mirror branches which match the regular expression. Requires GitLab Premium.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+				ConflictsWith:    []string{"only_mirror_protected_branches"},
+			},
+			"sync_triggers": {
+				Description: "Arbitrary map of values that, when changed, will trigger an update of the pull mirror.",
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"update_status": {
+				Description: "The status of the last update of the pull mirror, e.g. `finished` or `failed`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_error": {
+				Description: "The error of the last failed update of the pull mirror.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_update_at": {
+				Description: "The time of the last update of the pull mirror, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_successful_update_at": {
+				Description: "The time of the last successful update of the pull mirror, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+// pullMirrorProject holds the pull mirror settings of a project, including the branch regex which is not yet available in go-gitlab.
+type pullMirrorProject struct {
+	ImportURL                        string  `json:"import_url"`
+	Mirror                           bool    `json:"mirror"`
+	MirrorTriggerBuilds              bool    `json:"mirror_trigger_builds"`
+	MirrorOverwritesDivergedBranches bool    `json:"mirror_overwrites_diverged_branches"`
+	OnlyMirrorProtectedBranches      bool    `json:"only_mirror_protected_branches"`
+	MirrorBranchRegex                *string `json:"mirror_branch_regex"`
+}
+
+type editProjectPullMirrorOptions struct {
+	gitlab.EditProjectOptions
+	MirrorBranchRegex *string `json:"mirror_branch_regex,omitempty"`
+}
+
+func resourceGitlabProjectPullMirrorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+
+	if err := resourceGitlabProjectPullMirrorEdit(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectPullMirrorRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPullMirrorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project pull mirror %s", project)
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s", gitlab.PathEscape(project)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var settings pullMirrorProject
+	if _, err := client.Do(req, &settings); err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing pull mirror from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if settings.ImportURL == "" {
+		log.Printf("[DEBUG] gitlab project %s has no pull mirror, removing from state", project)
+		d.SetId("")
+		return nil
+	}
+
+	// NOTE: GitLab masks the credentials in the URL, they are kept in `auth_user` and `auth_password`.
+	importURL := settings.ImportURL
+	if parsedURL, err := url.Parse(importURL); err == nil {
+		parsedURL.User = nil
+		importURL = parsedURL.String()
+	}
+
+	d.Set("project", project)
+	d.Set("url", importURL)
+	d.Set("enabled", settings.Mirror)
+	d.Set("mirror_trigger_builds", settings.MirrorTriggerBuilds)
+	d.Set("mirror_overwrites_diverged_branches", settings.MirrorOverwritesDivergedBranches)
+	d.Set("only_mirror_protected_branches", settings.OnlyMirrorProtectedBranches)
+	mirrorBranchRegex := ""
+	if settings.MirrorBranchRegex != nil {
+		mirrorBranchRegex = *settings.MirrorBranchRegex
+	}
+	d.Set("mirror_branch_regex", mirrorBranchRegex)
+
+	updateStatus, lastError, lastUpdateAt, lastSuccessfulUpdateAt := "", "", "", ""
+	if settings.Mirror {
+		details, _, err := client.Projects.GetProjectPullMirrorDetails(project, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateStatus = details.UpdateStatus
+		lastError = details.LastError
+		if details.LastUpdateAt != nil {
+			lastUpdateAt = details.LastUpdateAt.Format(time.RFC3339)
+		}
+		if details.LastSuccessfulUpdateAt != nil {
+			lastSuccessfulUpdateAt = details.LastSuccessfulUpdateAt.Format(time.RFC3339)
+		}
+	}
+	d.Set("update_status", updateStatus)
+	d.Set("last_error", lastError)
+	d.Set("last_update_at", lastUpdateAt)
+	d.Set("last_successful_update_at", lastSuccessfulUpdateAt)
+	return nil
+}
+
+func resourceGitlabProjectPullMirrorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	if d.HasChangeExcept("sync_triggers") {
+		if err := resourceGitlabProjectPullMirrorEdit(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("sync_triggers") && d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] trigger update of gitlab project pull mirror %s", d.Id())
+		if _, err := client.Projects.StartMirroringProject(d.Id(), gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to trigger update of pull mirror of project %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceGitlabProjectPullMirrorRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPullMirrorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.EditProjectOptions{
+		Mirror:              gitlab.Bool(false),
+		MirrorTriggerBuilds: gitlab.Bool(false),
+	}
+
+	log.Printf("[DEBUG] disable gitlab project pull mirror %s", d.Id())
+	if _, _, err := client.Projects.EditProject(d.Id(), options, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectPullMirrorEdit configures the pull mirror using the project API,
+// which supports all GitLab versions in contrast to the dedicated pull mirror API.
+func resourceGitlabProjectPullMirrorEdit(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	importURL, err := constructImportUrl(d.Get("url").(string), d.Get("auth_user").(string), d.Get("auth_password").(string))
+	if err != nil {
+		return err
+	}
+
+	options := &editProjectPullMirrorOptions{
+		EditProjectOptions: gitlab.EditProjectOptions{
+			ImportURL:                        gitlab.String(importURL),
+			Mirror:                           gitlab.Bool(d.Get("enabled").(bool)),
+			MirrorTriggerBuilds:              gitlab.Bool(d.Get("mirror_trigger_builds").(bool)),
+			MirrorOverwritesDivergedBranches: gitlab.Bool(d.Get("mirror_overwrites_diverged_branches").(bool)),
+			OnlyMirrorProtectedBranches:      gitlab.Bool(d.Get("only_mirror_protected_branches").(bool)),
+		},
+	}
+	if d.HasChange("mirror_branch_regex") {
+		options.MirrorBranchRegex = gitlab.String(d.Get("mirror_branch_regex").(string))
+	}
+
+	// NOTE: go-gitlab doesn't support the mirror branch regex yet.
+	log.Printf("[DEBUG] configure gitlab project pull mirror for %s", project)
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s", gitlab.PathEscape(project)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
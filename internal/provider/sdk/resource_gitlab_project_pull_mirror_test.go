@@ -0,0 +1,100 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabProjectPullMirror_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	sourceProject := testutil.CreateProject(t)
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectPullMirrorDisabled,
+		Steps: []resource.TestStep{
+			// Create a pull mirror
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_pull_mirror" "this" {
+						project = "%d"
+						url     = "%s"
+					}
+				`, testProject.ID, sourceProject.HTTPURLToRepo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_project_pull_mirror.this", "update_status"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_pull_mirror.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_status", "last_error", "last_update_at", "last_successful_update_at"},
+			},
+			// Update the pull mirror settings and trigger an update
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_pull_mirror" "this" {
+						project                             = "%d"
+						url                                 = "%s"
+						mirror_trigger_builds               = true
+						mirror_overwrites_diverged_branches = true
+						mirror_branch_regex                 = "^release/.*$"
+
+						sync_triggers = {
+							sync = "1"
+						}
+					}
+				`, testProject.ID, sourceProject.HTTPURLToRepo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "mirror_trigger_builds", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "mirror_overwrites_diverged_branches", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "mirror_branch_regex", "^release/.*$"),
+				),
+			},
+			// Disable the pull mirror
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_pull_mirror" "this" {
+						project = "%d"
+						url     = "%s"
+						enabled = false
+					}
+				`, testProject.ID, sourceProject.HTTPURLToRepo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_pull_mirror.this", "update_status", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPullMirrorDisabled(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_pull_mirror" {
+			continue
+		}
+
+		project, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if project.Mirror {
+			return fmt.Errorf("pull mirror of project %s is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -76,6 +77,12 @@ func gitlabProjectShareGroupSchema() map[string]*schema.Schema {
 			Deprecated:       "Use `group_access` instead of the `access_level` attribute.",
 			ExactlyOneOf:     []string{"access_level", "group_access"},
 		},
+		"member_role_id": {
+			Description: "The ID of a custom member role to grant the group, e.g. the `member_role_id` attribute of the `gitlab_member_role` resource. Only available for Ultimate instances.",
+			Type:        schema.TypeInt,
+			ForceNew:    true,
+			Optional:    true,
+		},
 	}
 }
 
@@ -94,16 +101,25 @@ func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Neither `group_access` nor `access_level` (deprecated) is set")
 	}
 
-	options := &gitlab.ShareWithGroupOptions{
-		GroupID:     &groupId,
-		GroupAccess: &groupAccess,
+	options := &shareWithGroupOptions{
+		ShareWithGroupOptions: gitlab.ShareWithGroupOptions{
+			GroupID:     &groupId,
+			GroupAccess: &groupAccess,
+		},
+	}
+	if v, ok := d.GetOk("member_role_id"); ok {
+		options.MemberRoleID = gitlab.Int(v.(int))
 	}
 	log.Printf("[DEBUG] create gitlab project membership for %d in %s", options.GroupID, project)
 
-	_, err := client.Projects.ShareProjectWithGroup(project, options, gitlab.WithContext(ctx))
+	// NOTE: go-gitlab doesn't support the `member_role_id` attribute yet.
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/share", gitlab.PathEscape(project)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
 	groupIdString := strconv.Itoa(groupId)
 	d.SetId(utils.BuildTwoPartID(&project, &groupIdString))
 	return resourceGitlabProjectShareGroupRead(ctx, d, meta)
@@ -119,8 +135,12 @@ func resourceGitlabProjectShareGroupRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	projectInformation, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s", gitlab.PathEscape(project)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
+		return diag.FromErr(err)
+	}
+	var projectInformation sharedWithGroups
+	if _, err := client.Do(req, &projectInformation); err != nil {
 		if api.Is404(err) {
 			log.Printf("[DEBUG] failed to read gitlab project %s: %s", id, err)
 			d.SetId("")
@@ -179,12 +199,7 @@ func resourceGitlabProjectShareGroupDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
-func resourceGitlabProjectShareGroupSetToState(d *schema.ResourceData, group struct {
-	GroupID          int    `json:"group_id"`
-	GroupName        string `json:"group_name"`
-	GroupFullPath    string `json:"group_full_path"`
-	GroupAccessLevel int    `json:"group_access_level"`
-}, projectId *string) {
+func resourceGitlabProjectShareGroupSetToState(d *schema.ResourceData, group sharedWithGroup, projectId *string) {
 
 	//This cast is needed due to an inconsistency in the upstream API
 	//GroupAccessLevel is returned as an int but the map we lookup is sorted by the int alias AccessLevelValue
@@ -193,6 +208,10 @@ func resourceGitlabProjectShareGroupSetToState(d *schema.ResourceData, group str
 	d.Set("project", projectId)
 	d.Set("group_id", group.GroupID)
 	d.Set("group_access", api.AccessLevelValueToName[convertedAccessLevel])
+	// NOTE: older GitLab versions don't return the custom member role.
+	if group.MemberRoleID != nil {
+		d.Set("member_role_id", *group.MemberRoleID)
+	}
 
 	groupId := strconv.Itoa(group.GroupID)
 	d.SetId(utils.BuildTwoPartID(projectId, &groupId))
@@ -2921,3 +2921,111 @@ func TestErrors_constructImportURL(t *testing.T) {
 		})
 	}
 }
+
+func TestAccGitlabProject_DeletionModePermanent(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "15.11")
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectPermanentlyRemoved,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+						deletion_mode    = "permanent"
+					}
+				`, rInt),
+				Check: resource.TestCheckResourceAttr("gitlab_project.this", "deletion_mode", "permanent"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_RestorePendingDeletion(t *testing.T) {
+	testutil.SkipIfCE(t)
+	testutil.RunIfAtLeast(t, "15.11")
+
+	project := testutil.CreateProject(t)
+	if _, err := testutil.TestGitlabClient.Projects.DeleteProject(project.ID); err != nil {
+		t.Fatalf("failed to delete project %d: %v", project.ID, err)
+	}
+	pendingDeletion, _, err := testutil.TestGitlabClient.Projects.GetProject(project.ID, nil)
+	if err != nil || pendingDeletion.MarkedForDeletionAt == nil {
+		t.Skip("delayed project deletion is not enabled")
+	}
+
+	config := func(restore bool) string {
+		return fmt.Sprintf(`
+			resource "gitlab_project" "this" {
+				name                     = "%s"
+				path                     = "%s"
+				description              = "Restored by Terraform"
+				visibility_level         = "public"
+				deletion_mode            = "permanent"
+				restore_pending_deletion = %t
+			}
+		`, project.Name, project.Path, restore)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabProjectPermanentlyRemoved,
+		Steps: []resource.TestStep{
+			// The project pending deletion is looked up by its path, which GitLab derives from the name if it's not set
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name                     = "%s"
+						restore_pending_deletion = true
+					}
+				`, project.Name),
+				ExpectError: regexp.MustCompile(`all of ` + "`" + `path,restore_pending_deletion` + "`" + ` must be specified`),
+			},
+			// Creating a project at the path of a project pending deletion fails
+			{
+				Config:      config(false),
+				ExpectError: regexp.MustCompile(`is pending deletion and blocks its path`),
+			},
+			// Restore the project pending deletion and update it to match the configuration
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "id", fmt.Sprintf("%d", project.ID)),
+					resource.TestCheckResourceAttr("gitlab_project.this", "description", "Restored by Terraform"),
+					func(s *terraform.State) error {
+						restored, _, err := testutil.TestGitlabClient.Projects.GetProject(project.ID, nil)
+						if err != nil {
+							return err
+						}
+						if restored.MarkedForDeletionAt != nil {
+							return fmt.Errorf("project %d is still marked for deletion", project.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPermanentlyRemoved(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project" {
+			continue
+		}
+
+		_, _, err := testutil.TestGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err == nil {
+			return fmt.Errorf("project %s still exists", rs.Primary.ID)
+		}
+		if !api.Is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,15 +3,12 @@ package sdk
 import (
 	"context"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
@@ -19,20 +16,6 @@ import (
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
-// NOTE: this lock is a bit of a hack to prevent parallel calls to the GitLab Repository Files API.
-//
-//	If it is called concurrently, the API will return a 400 error along the lines of:
-//	```
-//	(400 Bad Request) DELETE https://gitlab.com/api/v4/projects/30716/repository/files/somefile.yaml: 400
-//	{message: 9:Could not update refs/heads/master. Please refresh and try again..}
-//	```
-//
-//	This lock only solves half of the problem, where the provider is responsible for
-//	the concurrency. The other half is if the API is called outside of terraform at the same time
-//	this resource makes calls to the API.
-//	To mitigate this, simple retries are used.
-var resourceGitlabRepositoryFileApiLock = newLock()
-
 var validEncodingValues = []string{
 	"base64",
 	"text",
@@ -110,118 +93,26 @@ var _ = registerResource("gitlab_repository_file", func() *schema.Resource {
 })
 
 func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
 	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
 	filePath := d.Get("file_path").(string)
-
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to create %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
-		return diag.FromErr(err)
-	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to create %s/%s", project, filePath)
-
-	client := meta.(*gitlab.Client)
 	content := d.Get("content").(string)
+	options := resourceGitlabRepositoryFileCommitOptions(d, d.Get("commit_message").(string), d.Timeout(schema.TimeoutCreate))
 
-	options := &gitlab.CreateFileOptions{
-		Branch:        gitlab.String(d.Get("branch").(string)),
-		AuthorEmail:   gitlab.String(d.Get("author_email").(string)),
-		AuthorName:    gitlab.String(d.Get("author_name").(string)),
-		Content:       gitlab.String(content),
-		CommitMessage: gitlab.String(d.Get("commit_message").(string)),
-	}
-	if startBranch, ok := d.GetOk("start_branch"); ok {
-		options.StartBranch = gitlab.String(startBranch.(string))
-	}
-	if executeFilemode, ok := d.GetOk("execute_filemode"); ok {
-		options.ExecuteFilemode = gitlab.Bool(executeFilemode.(bool))
-	}
-
-	// check if the encoding value is provided
-	if encoding, ok := d.GetOk("encoding"); ok {
-		options.Encoding = gitlab.String(encoding.(string))
-	}
-
+	var err error
 	if overwriteOnCreate, ok := d.GetOk("overwrite_on_create"); ok && overwriteOnCreate.(bool) {
-		readOptions := &gitlab.GetFileOptions{
-			Ref: gitlab.String(*options.Branch),
-		}
-		var existingRepositoryFile *gitlab.File
-		// Try to get the file to check if it exists
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-			var err error
-			existingRepositoryFile, _, err = client.RepositoryFiles.GetFile(project, filePath, readOptions, gitlab.WithContext(ctx))
-			if err != nil {
-				if isRefreshError(err) {
-					return retry.RetryableError(err)
-				}
-				if !api.Is404(err) {
-					return retry.NonRetryableError(err)
-				}
-			}
-
-			if existingRepositoryFile != nil {
-				log.Printf("[DEBUG] %s already exists and overwrite_on_create is true. File will be overwritten.", filePath)
-
-				updateOptions := &gitlab.UpdateFileOptions{
-					Branch:        gitlab.String(*options.Branch),
-					AuthorEmail:   gitlab.String(d.Get("author_email").(string)),
-					AuthorName:    gitlab.String(d.Get("author_name").(string)),
-					Content:       gitlab.String(content),
-					CommitMessage: gitlab.String(d.Get("commit_message").(string)),
-				}
-
-				// check if the encoding value is provided
-				if encoding, ok := d.GetOk("encoding"); ok {
-					updateOptions.Encoding = gitlab.String(encoding.(string))
-				}
-
-				if startBranch, ok := d.GetOk("start_branch"); ok {
-					updateOptions.StartBranch = gitlab.String(startBranch.(string))
-				}
-				if executeFilemode, ok := d.GetOk("execute_filemode"); ok {
-					updateOptions.ExecuteFilemode = gitlab.Bool(executeFilemode.(bool))
-				}
-
-				updateOptions.LastCommitID = gitlab.String(existingRepositoryFile.LastCommitID)
-				_, _, err := client.RepositoryFiles.UpdateFile(project, filePath, updateOptions, gitlab.WithContext(ctx))
-				if err != nil {
-					if isRefreshError(err) {
-						return retry.RetryableError(err)
-					}
-					return retry.NonRetryableError(err)
-				}
-
-			}
-			return nil
-		})
-
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		if existingRepositoryFile != nil {
-			d.SetId(resourceGitLabRepositoryFileBuildId(project, *options.Branch, existingRepositoryFile.FilePath))
-			return resourceGitlabRepositoryFileRead(ctx, d, meta)
-		}
+		log.Printf("[DEBUG] gitlab_repository_file: creating %s/%s, overwriting it if it already exists", project, filePath)
+		err = api.CommitRepositoryFile(ctx, client, project, filePath, content, options)
+	} else {
+		log.Printf("[DEBUG] gitlab_repository_file: creating %s/%s", project, filePath)
+		err = api.CreateRepositoryFile(ctx, client, project, filePath, content, options)
 	}
-
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		repositoryFile, _, err := client.RepositoryFiles.CreateFile(project, filePath, options, gitlab.WithContext(ctx))
-		if err != nil {
-			if isRefreshError(err) {
-				return retry.RetryableError(err)
-			}
-			return retry.NonRetryableError(err)
-		}
-
-		d.SetId(resourceGitLabRepositoryFileBuildId(project, repositoryFile.Branch, repositoryFile.FilePath))
-		return nil
-	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId(resourceGitLabRepositoryFileBuildId(project, branch, filePath))
 	return resourceGitlabRepositoryFileRead(ctx, d, meta)
 }
 
@@ -274,68 +165,21 @@ func resourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabRepositoryFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	project, branch, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
+	project, _, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to update %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
-		return diag.FromErr(err)
-	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to update %s/%s", project, filePath)
-
-	client := meta.(*gitlab.Client)
-
-	readOptions := &gitlab.GetFileOptions{
-		Ref: gitlab.String(branch),
-	}
-
 	content := d.Get("content").(string)
 	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
 		return diag.Errorf(`Invalid base64 string in "content". Ensure the content is base64 encoded, or use the "base64encode" terraform function to encode it.`)
 	}
 
-	updateOptions := &gitlab.UpdateFileOptions{
-		Branch:        gitlab.String(branch),
-		AuthorEmail:   gitlab.String(d.Get("author_email").(string)),
-		AuthorName:    gitlab.String(d.Get("author_name").(string)),
-		Content:       gitlab.String(content),
-		CommitMessage: gitlab.String(d.Get("commit_message").(string)),
-	}
-
-	// check if the encoding value is provided
-	if encoding, ok := d.GetOk("encoding"); ok {
-		updateOptions.Encoding = gitlab.String(encoding.(string))
-	}
-
-	if startBranch, ok := d.GetOk("start_branch"); ok {
-		updateOptions.StartBranch = gitlab.String(startBranch.(string))
-	}
-	if executeFilemode, ok := d.GetOk("execute_filemode"); ok {
-		updateOptions.ExecuteFilemode = gitlab.Bool(executeFilemode.(bool))
-	}
-
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		// NOTE: we also re-read the file to obtain an eventually changed `LastCommitID` for which we needed the refresh
-		existingRepositoryFile, _, err := client.RepositoryFiles.GetFile(project, filePath, readOptions, gitlab.WithContext(ctx))
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		updateOptions.LastCommitID = gitlab.String(existingRepositoryFile.LastCommitID)
-		_, _, err = client.RepositoryFiles.UpdateFile(project, filePath, updateOptions, gitlab.WithContext(ctx))
-		if err != nil {
-			if isRefreshError(err) {
-				return retry.RetryableError(err)
-			}
-			return retry.NonRetryableError(err)
-		}
+	client := meta.(*gitlab.Client)
+	options := resourceGitlabRepositoryFileCommitOptions(d, d.Get("commit_message").(string), d.Timeout(schema.TimeoutUpdate))
 
-		return nil
-	})
-	if err != nil {
+	log.Printf("[DEBUG] gitlab_repository_file: updating %s/%s", project, filePath)
+	if err := api.UpdateRepositoryFile(ctx, client, project, filePath, content, options); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -343,53 +187,37 @@ func resourceGitlabRepositoryFileUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabRepositoryFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	project, branch, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
+	project, _, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to delete %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
-		return diag.FromErr(err)
-	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to delete %s/%s", project, filePath)
-
 	client := meta.(*gitlab.Client)
+	options := resourceGitlabRepositoryFileCommitOptions(d, fmt.Sprintf("[DELETE]: %s", d.Get("commit_message").(string)), d.Timeout(schema.TimeoutDelete))
 
-	readOptions := &gitlab.GetFileOptions{
-		Ref: gitlab.String(branch),
-	}
-	deleteOptions := &gitlab.DeleteFileOptions{
-		Branch:        gitlab.String(d.Get("branch").(string)),
-		AuthorEmail:   gitlab.String(d.Get("author_email").(string)),
-		AuthorName:    gitlab.String(d.Get("author_name").(string)),
-		CommitMessage: gitlab.String(fmt.Sprintf("[DELETE]: %s", d.Get("commit_message").(string))),
+	log.Printf("[DEBUG] gitlab_repository_file: deleting %s/%s", project, filePath)
+	if err := api.DeleteRepositoryFile(ctx, client, project, filePath, options); err != nil {
+		return diag.Errorf("%s failed to delete repository file: %v", d.Id(), err)
 	}
 
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
-		// NOTE: we also re-read the file to obtain an eventually changed `LastCommitID` for which we needed the refresh
-
-		existingRepositoryFile, _, err := client.RepositoryFiles.GetFile(project, filePath, readOptions, gitlab.WithContext(ctx))
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	return nil
+}
 
-		deleteOptions.LastCommitID = gitlab.String(existingRepositoryFile.LastCommitID)
-		resp, err := client.RepositoryFiles.DeleteFile(project, filePath, deleteOptions, gitlab.WithContext(ctx))
-		if err != nil {
-			if isRefreshError(err) {
-				return retry.RetryableError(err)
-			}
-			return retry.NonRetryableError(fmt.Errorf("%s failed to delete repository file: (%s) %v", d.Id(), resp.Status, err))
-		}
-		return nil
-	})
-	if err != nil {
-		return diag.FromErr(err)
+// resourceGitlabRepositoryFileCommitOptions builds the options for the shared repository file helpers from the resource data.
+func resourceGitlabRepositoryFileCommitOptions(d *schema.ResourceData, commitMessage string, timeout time.Duration) *api.RepositoryFileCommitOptions {
+	options := &api.RepositoryFileCommitOptions{
+		Branch:        d.Get("branch").(string),
+		StartBranch:   d.Get("start_branch").(string),
+		CommitMessage: commitMessage,
+		AuthorEmail:   d.Get("author_email").(string),
+		AuthorName:    d.Get("author_name").(string),
+		Encoding:      d.Get("encoding").(string),
+		Timeout:       timeout,
 	}
-
-	return nil
+	if executeFilemode, ok := d.GetOk("execute_filemode"); ok {
+		options.ExecuteFilemode = gitlab.Bool(executeFilemode.(bool))
+	}
+	return options
 }
 
 func resourceGitLabRepositoryFileParseId(id string) (string, string, string, error) {
@@ -404,10 +232,3 @@ func resourceGitLabRepositoryFileParseId(id string) (string, string, string, err
 func resourceGitLabRepositoryFileBuildId(project string, branch string, filePath string) string {
 	return fmt.Sprintf("%s:%s:%s", project, branch, filePath)
 }
-
-func isRefreshError(err error) bool {
-	var httpErr *gitlab.ErrorResponse
-	return errors.As(err, &httpErr) &&
-		httpErr.Response.StatusCode == http.StatusBadRequest &&
-		strings.Contains(httpErr.Message, "Please refresh and try again")
-}
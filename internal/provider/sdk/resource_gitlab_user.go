@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -20,6 +21,67 @@ var validUserStateValues = []string{
 	"active",
 	"deactivated",
 	"blocked",
+	"banned",
+	"blocked_pending_approval",
+}
+
+// userStateTransitions are the supported transitions between the states of a user,
+// mapped to the actions which have to be performed in order.
+// NOTE: some transitions are not directly supported by GitLab, e.g. a blocked user cannot be deactivated,
+//
+//	therefore the user is brought back to the `active` state first.
+var userStateTransitions = map[string]map[string][]string{
+	"active": {
+		"blocked":     {"block"},
+		"deactivated": {"deactivate"},
+		"banned":      {"ban"},
+	},
+	"blocked": {
+		"active":      {"unblock"},
+		"deactivated": {"unblock", "deactivate"},
+		"banned":      {"unblock", "ban"},
+	},
+	"deactivated": {
+		"active":  {"activate"},
+		"blocked": {"block"},
+		"banned":  {"activate", "ban"},
+	},
+	"banned": {
+		"active":      {"unban"},
+		"blocked":     {"unban", "block"},
+		"deactivated": {"unban", "deactivate"},
+	},
+	"blocked_pending_approval": {
+		"active":      {"approve"},
+		"blocked":     {"block"},
+		"deactivated": {"approve", "deactivate"},
+		"banned":      {"approve", "ban"},
+	},
+}
+
+// userStateActions are the API calls to perform the actions of a state transition.
+var userStateActions = map[string]func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error{
+	"block": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.BlockUser(user, options...)
+	},
+	"unblock": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.UnblockUser(user, options...)
+	},
+	"deactivate": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.DeactivateUser(user, options...)
+	},
+	"activate": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.ActivateUser(user, options...)
+	},
+	"ban": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.BanUser(user, options...)
+	},
+	"unban": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.UnbanUser(user, options...)
+	},
+	"approve": func(client *gitlab.Client, user int, options ...gitlab.RequestOptionFunc) error {
+		return client.Users.ApproveUser(user, options...)
+	},
 }
 
 var _ = registerResource("gitlab_user", func() *schema.Resource {
@@ -30,6 +92,9 @@ var _ = registerResource("gitlab_user", func() *schema.Resource {
 
 -> You must specify either password or reset_password.
 
+-> The ` + "`state`" + ` of a user can be changed between all states, except back to ` + "`blocked_pending_approval`" + `.
+   A user pending approval is approved by changing the ` + "`state`" + ` and rejected by destroying the resource.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html)`,
 
 		CreateContext: resourceGitlabUserCreate,
@@ -39,6 +104,7 @@ var _ = registerResource("gitlab_user", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceGitlabUserCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -113,12 +179,18 @@ var _ = registerResource("gitlab_user", func() *schema.Resource {
 				Optional:    true,
 			},
 			"state": {
-				Description:      fmt.Sprintf("String, defaults to 'active'. The state of the user account. Valid values are %s.", utils.RenderValueListForDocs(validUserStateValues)),
+				Description:      fmt.Sprintf("String, defaults to 'active'. The state of the user account. Valid values are %s. The `blocked_pending_approval` state can't be set, it can only be kept for users which are pending approval.", utils.RenderValueListForDocs(validUserStateValues)),
 				Type:             schema.TypeString,
 				Optional:         true,
 				Default:          "active",
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validUserStateValues, false)),
 			},
+			"hard_delete": {
+				Description: "Boolean, defaults to false. Whether to delete the contributions of the user when the resource is destroyed, like issues, merge requests and notes, as well as the groups the user is the only owner of. Otherwise the contributions are moved to the system-wide ghost user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"namespace_id": {
 				Description: "The ID of the user's namespace. Available since GitLab 14.10.",
 				Type:        schema.TypeInt,
@@ -171,18 +243,8 @@ func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%d", user.ID))
 
-	if d.Get("state") == "blocked" {
-		err := client.Users.BlockUser(user.ID, gitlab.WithContext(ctx))
-
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	} else if d.Get("state") == "deactivated" {
-		err := client.Users.DeactivateUser(user.ID, gitlab.WithContext(ctx))
-
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if err := resourceGitlabUserTransitionState(ctx, client, user.ID, user.State, d.Get("state").(string)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceGitlabUserRead(ctx, d, meta)
@@ -257,31 +319,7 @@ func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("state") {
 		oldState, newState := d.GetChange("state")
-		var err error
-		// NOTE: yes, this can be written much more consice, however, for the sake of understanding the behavior,
-		//       of the API and the allowed state transitions of GitLab, let's keep it as-is and enjoy the readability.
-		if newState == "active" && oldState == "blocked" {
-			err = client.Users.UnblockUser(id, gitlab.WithContext(ctx))
-		} else if newState == "active" && oldState == "deactivated" {
-			err = client.Users.ActivateUser(id, gitlab.WithContext(ctx))
-		} else if newState == "blocked" && oldState == "active" {
-			err = client.Users.BlockUser(id, gitlab.WithContext(ctx))
-		} else if newState == "blocked" && oldState == "deactivated" {
-			err = client.Users.BlockUser(id, gitlab.WithContext(ctx))
-		} else if newState == "deactivated" && oldState == "active" {
-			err = client.Users.DeactivateUser(id, gitlab.WithContext(ctx))
-		} else if newState == "deactivated" && oldState == "blocked" {
-			// a blocked user cannot be deactivated, GitLab will return an error, like:
-			// `403 Forbidden - A blocked user cannot be deactivated by the API`
-			// we have to unblock the user first
-			err = client.Users.UnblockUser(id, gitlab.WithContext(ctx))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			err = client.Users.DeactivateUser(id, gitlab.WithContext(ctx))
-		}
-
-		if err != nil {
+		if err := resourceGitlabUserTransitionState(ctx, client, id, oldState.(string), newState.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -295,8 +333,21 @@ func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	id, _ := strconv.Atoi(d.Id())
 
-	if _, err := client.Users.DeleteUser(id, gitlab.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+	// A user pending approval is rejected instead, which deletes the user and notifies them.
+	if d.Get("state").(string) == "blocked_pending_approval" {
+		if err := client.Users.RejectUser(id, gitlab.WithContext(ctx)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		// NOTE: go-gitlab doesn't support the `hard_delete` option yet.
+		options := &deleteUserOptions{HardDelete: gitlab.Bool(d.Get("hard_delete").(bool))}
+		req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("users/%d", id), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	stateConf := &retry.StateChangeConf{
@@ -320,3 +371,48 @@ func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+// resourceGitlabUserCustomizeDiff validates that the planned state of the user can be reached from its current state.
+func resourceGitlabUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A new user is always created in the `active` state
+	oldState, newState := "active", d.Get("state").(string)
+	if d.Id() != "" {
+		if !d.HasChange("state") {
+			return nil
+		}
+		o, n := d.GetChange("state")
+		oldState, newState = o.(string), n.(string)
+	}
+
+	if oldState == newState {
+		return nil
+	}
+	if _, ok := userStateTransitions[oldState][newState]; !ok {
+		return fmt.Errorf("the state of the user can't be changed from %q to %q", oldState, newState)
+	}
+	return nil
+}
+
+// resourceGitlabUserTransitionState performs the actions to change the state of the user.
+func resourceGitlabUserTransitionState(ctx context.Context, client *gitlab.Client, id int, oldState string, newState string) error {
+	if oldState == newState {
+		return nil
+	}
+
+	actions, ok := userStateTransitions[oldState][newState]
+	if !ok {
+		return fmt.Errorf("the state of the user can't be changed from %q to %q", oldState, newState)
+	}
+
+	for _, action := range actions {
+		log.Printf("[DEBUG] %s gitlab user %d to change its state from %q to %q", action, id, oldState, newState)
+		if err := userStateActions[action](client, id, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to %s user %d: %w", action, id, err)
+		}
+	}
+	return nil
+}
+
+type deleteUserOptions struct {
+	HardDelete *bool `url:"hard_delete,omitempty" json:"hard_delete,omitempty"`
+}
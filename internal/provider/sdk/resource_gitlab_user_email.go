@@ -0,0 +1,168 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_user_email", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`" + `gitlab_user_email` + "`" + ` resource allows to manage the lifecycle of a secondary email of a user.
+
+-> Managing emails of other users requires admin privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#add-email-for-user)`,
+
+		CreateContext: resourceGitlabUserEmailCreate,
+		ReadContext:   resourceGitlabUserEmailRead,
+		DeleteContext: resourceGitlabUserEmailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"email": {
+				Description: "The email address.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"skip_confirmation": {
+				Description: "Skip the confirmation of the email and mark it as verified. Only applies when creating the email. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				ForceNew:    true,
+				Optional:    true,
+				Default:     false,
+			},
+			"email_id": {
+				Description: "The ID of the email.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"confirmed_at": {
+				Description: "The time when the email was confirmed. Empty if the email is not confirmed yet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabUserEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	options := &gitlab.AddEmailOptions{
+		Email:            gitlab.String(d.Get("email").(string)),
+		SkipConfirmation: gitlab.Bool(d.Get("skip_confirmation").(bool)),
+	}
+
+	log.Printf("[DEBUG] create gitlab user email %s for user %d", *options.Email, userID)
+	email, _, err := client.Users.AddEmailForUser(userID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIDForID := strconv.Itoa(userID)
+	emailIDForID := strconv.Itoa(email.ID)
+	d.SetId(utils.BuildTwoPartID(&userIDForID, &emailIDForID))
+	return resourceGitlabUserEmailRead(ctx, d, meta)
+}
+
+func resourceGitlabUserEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, emailID, err := resourceGitlabUserEmailParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse user email resource id: %s: %v", d.Id(), err)
+	}
+
+	options := &gitlab.ListEmailsForUserOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	var email *gitlab.Email
+	for options.Page != 0 && email == nil {
+		emails, resp, err := client.Users.ListEmailsForUser(userID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, e := range emails {
+			if e.ID == emailID {
+				email = e
+				break
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	if email == nil {
+		log.Printf("[DEBUG] Could not find email %d for user %d", emailID, userID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("email_id", emailID)
+	d.Set("email", email.Email)
+	confirmedAt := ""
+	if email.ConfirmedAt != nil {
+		confirmedAt = email.ConfirmedAt.Format(time.RFC3339)
+	}
+	d.Set("confirmed_at", confirmedAt)
+	return nil
+}
+
+func resourceGitlabUserEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, emailID, err := resourceGitlabUserEmailParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse user email resource id: %s: %v", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab user email %d for user %d", emailID, userID)
+	if _, err := client.Users.DeleteEmailForUser(userID, emailID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabUserEmailParseID(id string) (int, int, error) {
+	userIDFromID, emailIDFromID, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(userIDFromID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("user id %q is not a number: %w", userIDFromID, err)
+	}
+	emailID, err := strconv.Atoi(emailIDFromID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("email id %q is not a number: %w", emailIDFromID, err)
+	}
+
+	return userID, emailID, nil
+}
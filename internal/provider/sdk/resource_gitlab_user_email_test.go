@@ -0,0 +1,90 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabUserEmail_basic(t *testing.T) {
+	testUser := testutil.CreateUsers(t, 1)[0]
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("acctest"))
+	updatedEmail := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("acctest"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserEmailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_email" "this" {
+						user_id = %d
+						email   = "%s"
+					}
+				`, testUser.ID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_user_email.this", "email_id"),
+					resource.TestCheckResourceAttr("gitlab_user_email.this", "confirmed_at", ""),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user_email.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_confirmation"},
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_email" "this" {
+						user_id           = %d
+						email             = "%s"
+						skip_confirmation = true
+					}
+				`, testUser.ID, updatedEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_email.this", "email", updatedEmail),
+					resource.TestCheckResourceAttrSet("gitlab_user_email.this", "confirmed_at"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user_email.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_confirmation"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserEmailDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_email" {
+			continue
+		}
+
+		userID, emailID, err := resourceGitlabUserEmailParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		emails, _, err := testutil.TestGitlabClient.Users.ListEmailsForUser(userID, &gitlab.ListEmailsForUserOptions{PerPage: 100})
+		if err != nil {
+			return err
+		}
+		for _, email := range emails {
+			if email.ID == emailID {
+				return fmt.Errorf("email %d of user %d still exists", emailID, userID)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_user_identity", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`" + `gitlab_user_identity` + "`" + ` resource allows to manage the lifecycle of an external identity of a user,
+which maps the user to an account of an authentication provider, like SAML or LDAP.
+
+-> A user can only have a single identity per provider.
+
+-> This resource requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#user-modification)`,
+
+		CreateContext: resourceGitlabUserIdentitySet,
+		ReadContext:   resourceGitlabUserIdentityRead,
+		UpdateContext: resourceGitlabUserIdentitySet,
+		DeleteContext: resourceGitlabUserIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"identity_provider": {
+				Description:  "The name of the authentication provider, as configured in the `omniauth` or `ldap` section of the GitLab configuration, e.g. `saml` or `ldapmain`.",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"extern_uid": {
+				Description:  "The ID of the user at the authentication provider, e.g. the SAML `NameID` or the LDAP DN.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+})
+
+func resourceGitlabUserIdentitySet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+	provider := d.Get("identity_provider").(string)
+
+	options := &gitlab.ModifyUserOptions{
+		Provider:  gitlab.String(provider),
+		ExternUID: gitlab.String(d.Get("extern_uid").(string)),
+	}
+
+	log.Printf("[DEBUG] set gitlab user identity %s for user %d", provider, userID)
+	if _, _, err := client.Users.ModifyUser(userID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIDForID := strconv.Itoa(userID)
+	d.SetId(utils.BuildTwoPartID(&userIDForID, &provider))
+	return resourceGitlabUserIdentityRead(ctx, d, meta)
+}
+
+func resourceGitlabUserIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, provider, err := resourceGitlabUserIdentityParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse user identity resource id: %s: %v", d.Id(), err)
+	}
+
+	user, _, err := client.Users.GetUser(userID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab user %d not found, removing identity %s from state", userID, provider)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var identity *gitlab.UserIdentity
+	for _, i := range user.Identities {
+		if i.Provider == provider {
+			identity = i
+			break
+		}
+	}
+
+	if identity == nil {
+		log.Printf("[DEBUG] Could not find identity %s for user %d", provider, userID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("identity_provider", identity.Provider)
+	d.Set("extern_uid", identity.ExternUID)
+	return nil
+}
+
+func resourceGitlabUserIdentityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, provider, err := resourceGitlabUserIdentityParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse user identity resource id: %s: %v", d.Id(), err)
+	}
+
+	// NOTE: go-gitlab doesn't support deleting an identity yet.
+	log.Printf("[DEBUG] delete gitlab user identity %s for user %d", provider, userID)
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("users/%d/identities/%s", userID, gitlab.PathEscape(provider)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabUserIdentityParseID(id string) (int, string, error) {
+	userIDFromID, provider, err := utils.ParseTwoPartID(id)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := strconv.Atoi(userIDFromID)
+	if err != nil {
+		return 0, "", fmt.Errorf("user id %q is not a number: %w", userIDFromID, err)
+	}
+
+	return userID, provider, nil
+}
@@ -0,0 +1,84 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabUserIdentity_basic(t *testing.T) {
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_identity" "this" {
+						user_id           = %d
+						identity_provider = "saml"
+						extern_uid        = "%s@example.com"
+					}
+				`, testUser.ID, testUser.Username),
+				Check: resource.TestCheckResourceAttr("gitlab_user_identity.this", "id", fmt.Sprintf("%d:saml", testUser.ID)),
+			},
+			{
+				ResourceName:      "gitlab_user_identity.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_identity" "this" {
+						user_id           = %d
+						identity_provider = "saml"
+						extern_uid        = "%s@corp.example.com"
+					}
+				`, testUser.ID, testUser.Username),
+				Check: resource.TestCheckResourceAttr("gitlab_user_identity.this", "extern_uid", fmt.Sprintf("%s@corp.example.com", testUser.Username)),
+			},
+			{
+				ResourceName:      "gitlab_user_identity.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserIdentityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_identity" {
+			continue
+		}
+
+		userID, provider, err := resourceGitlabUserIdentityParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		user, _, err := testutil.TestGitlabClient.Users.GetUser(userID, gitlab.GetUsersOptions{})
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+		for _, identity := range user.Identities {
+			if identity.Provider == provider {
+				return fmt.Errorf("identity %s of user %d still exists", provider, userID)
+			}
+		}
+	}
+	return nil
+}
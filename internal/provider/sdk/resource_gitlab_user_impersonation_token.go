@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_user_impersonation_token", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_user_impersonation_token`" + ` resource allows to manage the lifecycle of an impersonation token for a specified user.
+
+An impersonation token is a special type of personal access token, which can only be created by an administrator and allows to act as the user.
+
+-> This resource requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#create-an-impersonation-token)`,
+
+		CreateContext: resourceGitlabUserImpersonationTokenCreate,
+		ReadContext:   resourceGitlabUserImpersonationTokenRead,
+		DeleteContext: resourceGitlabUserImpersonationTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: gitlabUserTokenSchema("impersonation token"),
+	}
+})
+
+func resourceGitlabUserImpersonationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.CreateImpersonationTokenOptions{
+		Name:   gitlab.String(d.Get("name").(string)),
+		Scopes: stringSetToStringSlice(d.Get("scopes").(*schema.Set)),
+	}
+
+	userID := d.Get("user_id").(int)
+	log.Printf("[DEBUG] create gitlab ImpersonationToken %s (scopes: %s) for user ID %d", *options.Name, options.Scopes, userID)
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		parsedExpiresAt, err := time.Parse("2006-01-02", v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse expires_at '%s' as ISO8601 formatted date: %v", v.(string), err)
+		}
+
+		options.ExpiresAt = &parsedExpiresAt
+	}
+
+	impersonationToken, _, err := client.Users.CreateImpersonationToken(userID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", userID, impersonationToken.ID))
+	// NOTE: the token can only be read once after creating it
+	d.Set("token", impersonationToken.Token)
+
+	return resourceGitlabUserImpersonationTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabUserImpersonationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab ImpersonationToken %d, user ID %d", tokenID, userID)
+
+	impersonationToken, _, err := client.Users.GetImpersonationToken(userID, tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab ImpersonationToken %d for user ID %d not found, removing from state", tokenID, userID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// A revoked token can't be used anymore, therefore it's considered as gone.
+	if impersonationToken.Revoked {
+		log.Printf("[DEBUG] gitlab ImpersonationToken %d for user ID %d is revoked, removing from state", tokenID, userID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("name", impersonationToken.Name)
+	if impersonationToken.ExpiresAt != nil {
+		d.Set("expires_at", impersonationToken.ExpiresAt.String())
+	}
+	d.Set("active", impersonationToken.Active)
+	d.Set("created_at", impersonationToken.CreatedAt.Format(time.RFC3339))
+	d.Set("revoked", impersonationToken.Revoked)
+
+	if err = d.Set("scopes", impersonationToken.Scopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabUserImpersonationTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Delete gitlab ImpersonationToken %s", d.Id())
+	_, err = client.Users.RevokeImpersonationToken(userID, tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabUserImpersonationToken_basic(t *testing.T) {
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserImpersonationTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_impersonation_token" "this" {
+						user_id    = %d
+						name       = "automation"
+						scopes     = ["api", "read_repository"]
+						expires_at = "2030-01-01"
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_user_impersonation_token.this", "token"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "revoked", "false"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "expires_at", "2030-01-01"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user_impersonation_token.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Changing the scopes creates a new token
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_impersonation_token" "this" {
+						user_id    = %d
+						name       = "automation"
+						scopes     = ["read_api"]
+						expires_at = "2030-01-01"
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_user_impersonation_token.this", "token"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "scopes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserImpersonationTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_impersonation_token" {
+			continue
+		}
+
+		userID, tokenID, err := resourceGitLabPersonalAccessTokenParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		token, _, err := testutil.TestGitlabClient.Users.GetImpersonationToken(userID, tokenID)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+		if !token.Revoked {
+			return fmt.Errorf("impersonation token %d of user %d is not revoked", tokenID, userID)
+		}
+	}
+	return nil
+}
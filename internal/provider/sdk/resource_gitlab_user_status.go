@@ -0,0 +1,217 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var validUserStatusAvailabilityValues = []string{"not_set", "busy"}
+
+var validUserStatusClearStatusAfterValues = []string{"30_minutes", "3_hours", "8_hours", "1_day", "3_days", "7_days", "30_days"}
+
+var _ = registerResource("gitlab_user_status", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`" + `gitlab_user_status` + "`" + ` resource allows to manage the status of the current user or a specific user.
+
+-> Managing the status of other users requires admin privileges, because the status is set on behalf of the user with sudo.
+
+-> Destroying this resource clears the status of the user.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#set-user-status)`,
+
+		CreateContext: resourceGitlabUserStatusSet,
+		ReadContext:   resourceGitlabUserStatusRead,
+		UpdateContext: resourceGitlabUserStatusSet,
+		DeleteContext: resourceGitlabUserStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user to set the status of. If this field is omitted, this resource manages the status of the current user.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Optional:    true,
+				Computed:    true,
+			},
+			"emoji": {
+				Description: "The name of the emoji to use as status, e.g. `coffee`. If omitted, `speech_balloon` is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"message": {
+				Description:  "The message to set as status. Can contain emoji codes, e.g. `:coffee:`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"availability": {
+				Description:  fmt.Sprintf("The availability of the user. Valid values are: %s. Defaults to `not_set`.", utils.RenderValueListForDocs(validUserStatusAvailabilityValues)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "not_set",
+				ValidateFunc: validation.StringInSlice(validUserStatusAvailabilityValues, false),
+			},
+			"clear_status_after": {
+				Description:  fmt.Sprintf("Automatically clear the status after the given duration. Valid values are: %s. The status is set again on the next apply once it has been cleared.", utils.RenderValueListForDocs(validUserStatusClearStatusAfterValues)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validUserStatusClearStatusAfterValues, false),
+			},
+			"clear_status_at": {
+				Description: "The time when the status is automatically cleared, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabUserStatusSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, requestOptions, err := resourceGitlabUserStatusRequestOptions(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &userStatusOptions{
+		Emoji:        d.Get("emoji").(string),
+		Message:      d.Get("message").(string),
+		Availability: d.Get("availability").(string),
+	}
+	if v, ok := d.GetOk("clear_status_after"); ok {
+		options.ClearStatusAfter = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] set gitlab user status for user %d", userID)
+	req, err := client.NewRequest(http.MethodPut, "user/status", options, requestOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.Errorf("failed to set status of user %d: %s", userID, err)
+	}
+
+	d.SetId(strconv.Itoa(userID))
+	return resourceGitlabUserStatusRead(ctx, d, meta)
+}
+
+func resourceGitlabUserStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse user status resource id: %s: %v", d.Id(), err)
+	}
+
+	// NOTE: go-gitlab doesn't support the `clear_status_at` attribute yet.
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/status", userID), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var status userStatus
+	if _, err := client.Do(req, &status); err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab user %d not found, removing status from state", userID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// A cleared status is considered as gone, so that it's set again.
+	if status.Emoji == "" && status.Message == "" && status.Availability != "busy" {
+		log.Printf("[DEBUG] gitlab user status of user %d is cleared, removing from state", userID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("emoji", status.Emoji)
+	d.Set("message", status.Message)
+	d.Set("availability", status.Availability)
+	clearStatusAt := ""
+	if status.ClearStatusAt != nil {
+		clearStatusAt = status.ClearStatusAt.Format(time.RFC3339)
+	}
+	d.Set("clear_status_at", clearStatusAt)
+	return nil
+}
+
+func resourceGitlabUserStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	userID, requestOptions, err := resourceGitlabUserStatusRequestOptions(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// NOTE: an empty emoji and message clear the status.
+	log.Printf("[DEBUG] clear gitlab user status for user %d", userID)
+	req, err := client.NewRequest(http.MethodPut, "user/status", &userStatusOptions{Availability: "not_set"}, requestOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil && !api.Is404(err) {
+		return diag.Errorf("failed to clear status of user %d: %s", userID, err)
+	}
+
+	return nil
+}
+
+// resourceGitlabUserStatusRequestOptions returns the ID of the user to manage the status of and the options to act as that user.
+// The status can only be set by the user itself, therefore the requests for other users are sent with sudo.
+func resourceGitlabUserStatusRequestOptions(ctx context.Context, d *schema.ResourceData, client *gitlab.Client) (int, []gitlab.RequestOptionFunc, error) {
+	requestOptions := []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)}
+
+	if v, ok := d.GetOk("user_id"); ok {
+		currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		userID := v.(int)
+		if currentUser.ID != userID {
+			if !currentUser.IsAdmin {
+				return 0, nil, fmt.Errorf("current user needs to be admin when managing the status of another user")
+			}
+			requestOptions = append(requestOptions, gitlab.WithSudo(userID))
+		}
+		return userID, requestOptions, nil
+	}
+
+	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	return currentUser.ID, requestOptions, nil
+}
+
+// userStatus is the API representation of a user status, including the attributes which are not yet available in go-gitlab.
+type userStatus struct {
+	Emoji         string     `json:"emoji"`
+	Message       string     `json:"message"`
+	Availability  string     `json:"availability"`
+	ClearStatusAt *time.Time `json:"clear_status_at"`
+}
+
+// userStatusOptions are the options to set a user status. The emoji and message must always be sent, because omitting them clears them.
+type userStatusOptions struct {
+	Emoji            string  `json:"emoji"`
+	Message          string  `json:"message"`
+	Availability     string  `json:"availability,omitempty"`
+	ClearStatusAfter *string `json:"clear_status_after,omitempty"`
+}
@@ -0,0 +1,92 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabUserStatus_basic(t *testing.T) {
+	testUser := testutil.CreateUsers(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserStatusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_status" "this" {
+						user_id = %d
+						emoji   = "coffee"
+						message = "On a break"
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_status.this", "availability", "not_set"),
+					resource.TestCheckResourceAttr("gitlab_user_status.this", "clear_status_at", ""),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_status.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_status" "this" {
+						user_id            = %d
+						emoji              = "palm_tree"
+						message            = "On vacation"
+						availability       = "busy"
+						clear_status_after = "7_days"
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_status.this", "emoji", "palm_tree"),
+					resource.TestCheckResourceAttr("gitlab_user_status.this", "availability", "busy"),
+					resource.TestCheckResourceAttrSet("gitlab_user_status.this", "clear_status_at"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user_status.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"clear_status_after"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserStatusDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_status" {
+			continue
+		}
+
+		userID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		status, _, err := testutil.TestGitlabClient.Users.GetUserStatus(userID)
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return err
+		}
+		if status.Emoji != "" || status.Message != "" {
+			return fmt.Errorf("status of user %d is not cleared", userID)
+		}
+	}
+	return nil
+}
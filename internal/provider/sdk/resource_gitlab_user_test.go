@@ -440,6 +440,107 @@ func TestAccGitlabUser_password_reset(t *testing.T) {
 	})
 }
 
+func TestAccGitlabUser_stateTransitions(t *testing.T) {
+	rInt := acctest.RandInt()
+	// NOTE: the password must not change between the steps, because changing it re-creates the user.
+	password := acctest.RandString(16)
+	config := func(state string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_user" "foo" {
+				name        = "foo %d"
+				username    = "listest%d"
+				password    = "%s"
+				email       = "listest%d@ssss.com"
+				state       = "%s"
+				hard_delete = true
+			}
+		`, rInt, rInt, password, rInt, state)
+	}
+
+	// The state transitions must be applied to the same user instead of re-creating it.
+	var userID string
+	testAccCheckSameUser := func(s *terraform.State) error {
+		id := s.RootModule().Resources["gitlab_user.foo"].Primary.ID
+		if userID == "" {
+			userID = id
+		} else if id != userID {
+			return fmt.Errorf("user was re-created: ID changed from %s to %s", userID, id)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserDestroy,
+		Steps: []resource.TestStep{
+			// Create a banned user
+			{
+				Config: config("banned"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user.foo", "state", "banned"),
+					testAccCheckSameUser,
+				),
+			},
+			// Banned users can only be blocked after unbanning them
+			{
+				Config: config("blocked"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user.foo", "state", "blocked"),
+					testAccCheckSameUser,
+				),
+			},
+			{
+				Config: config("banned"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user.foo", "state", "banned"),
+					testAccCheckSameUser,
+				),
+			},
+			{
+				Config: config("active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user.foo", "state", "active"),
+					testAccCheckSameUser,
+				),
+			},
+			{
+				ResourceName:            "gitlab_user.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "skip_confirmation", "hard_delete"},
+			},
+			// A user can't be put back into the pending approval state
+			{
+				Config:      config("blocked_pending_approval"),
+				ExpectError: regexp.MustCompile(`the state of the user can't be changed from "active" to "blocked_pending_approval"`),
+			},
+		},
+	})
+}
+
+func TestAccGitlabUser_createPendingApproval(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user" "foo" {
+						name     = "foo %d"
+						username = "listest%d"
+						password = "%s"
+						email    = "listest%d@ssss.com"
+						state    = "blocked_pending_approval"
+					}
+				`, rInt, rInt, acctest.RandString(16), rInt),
+				ExpectError: regexp.MustCompile(`the state of the user can't be changed from "active" to "blocked_pending_approval"`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabUserExists(n string, user *gitlab.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+func gitlabAccessRequestsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The list of pending access requests.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"user_id": {
+					Description: "The id of the user who requested access.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"username": {
+					Description: "The username of the user who requested access.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"name": {
+					Description: "The name of the user who requested access.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"state": {
+					Description: "The state of the user who requested access, e.g. `active`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"requested_at": {
+					Description: "The time when the access was requested, RFC3339 format.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"access_level": {
+					Description: "The access level the user gets when the request is approved without another access level.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenGitlabAccessRequests(accessRequests []*gitlab.AccessRequest) []interface{} {
+	values := []interface{}{}
+	for _, accessRequest := range accessRequests {
+		requestedAt := ""
+		if accessRequest.RequestedAt != nil {
+			requestedAt = accessRequest.RequestedAt.Format(time.RFC3339)
+		}
+		values = append(values, map[string]interface{}{
+			"user_id":      accessRequest.ID,
+			"username":     accessRequest.Username,
+			"name":         accessRequest.Name,
+			"state":        accessRequest.State,
+			"requested_at": requestedAt,
+			"access_level": api.AccessLevelValueToName[accessRequest.AccessLevel],
+		})
+	}
+	return values
+}
@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// The project and group resources share the handling of how they are deleted on destroy
+// and whether a project or group pending deletion is restored on create.
+
+func deletionModeSchema(kind string, validDeletionModes []string, defaultDescription string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("How the %[1]s is deleted on destroy. Valid values are: %[2]s. "+
+			"`soft` deletes the %[1]s, which only marks it for deletion if delayed deletion is enabled on GitLab EE. "+
+			"`permanent` additionally removes a %[1]s marked for deletion immediately. %[3]s",
+			kind, utils.RenderValueListForDocs(validDeletionModes), defaultDescription),
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validDeletionModes, false)),
+		Optional:         true,
+	}
+}
+
+func restorePendingDeletionSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Only used on creation. Set to `true` to restore a %[1]s pending deletion at the same path instead of failing to create the %[1]s. "+
+			"The restored %[1]s is updated to match the configuration. Requires `path` to be set, because the %[1]s is looked up by its path. "+
+			"Only available on GitLab EE with delayed deletion.", kind),
+		Type:         schema.TypeBool,
+		Optional:     true,
+		RequiredWith: []string{"path"},
+	}
+}
+
+type permanentlyRemoveOptions struct {
+	PermanentlyRemove *bool   `url:"permanently_remove,omitempty" json:"permanently_remove,omitempty"`
+	FullPath          *string `url:"full_path,omitempty" json:"full_path,omitempty"`
+}
+
+// permanentlyRemove removes a project or group which is already marked for deletion immediately.
+// The kind is either `projects` or `groups`.
+//
+// NOTE: go-gitlab doesn't support the `permanently_remove` option yet.
+func permanentlyRemove(ctx context.Context, client *gitlab.Client, kind string, id string, fullPath string) error {
+	options := &permanentlyRemoveOptions{
+		PermanentlyRemove: gitlab.Bool(true),
+		FullPath:          gitlab.String(fullPath),
+	}
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", kind, gitlab.PathEscape(id)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,234 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var validGroupSamlLinkAccessLevelNames = []string{
+	"guest",
+	"reporter",
+	"developer",
+	"maintainer",
+	"owner",
+}
+
+// samlGroupLink is a SAML group link including the custom member role, which is not yet available in go-gitlab.
+type samlGroupLink struct {
+	Name         string                  `json:"name"`
+	AccessLevel  gitlab.AccessLevelValue `json:"access_level"`
+	MemberRoleID *int                    `json:"member_role_id,omitempty"`
+}
+
+// ldapGroupLink is an LDAP group link including the custom member role, which is not yet available in go-gitlab.
+type ldapGroupLink struct {
+	CN           string                  `json:"cn"`
+	Filter       string                  `json:"filter"`
+	GroupAccess  gitlab.AccessLevelValue `json:"group_access"`
+	Provider     string                  `json:"provider"`
+	MemberRoleID *int                    `json:"member_role_id,omitempty"`
+}
+
+type addGroupSAMLLinkOptions struct {
+	gitlab.AddGroupSAMLLinkOptions
+	MemberRoleID *int `json:"member_role_id,omitempty"`
+}
+
+type addGroupLDAPLinkOptions struct {
+	gitlab.AddGroupLDAPLinkOptions
+	MemberRoleID *int `json:"member_role_id,omitempty"`
+}
+
+func listGroupSAMLLinks(ctx context.Context, client *gitlab.Client, group string) ([]*samlGroupLink, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/saml_group_links", gitlab.PathEscape(group)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	var links []*samlGroupLink
+	if _, err := client.Do(req, &links); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func addGroupSAMLLink(ctx context.Context, client *gitlab.Client, group string, options *addGroupSAMLLinkOptions) (*samlGroupLink, error) {
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/saml_group_links", gitlab.PathEscape(group)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	var link samlGroupLink
+	if _, err := client.Do(req, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// listGroupLDAPLinks lists the LDAP links of a group. The API responds with a 404 if there are no LDAP links,
+// which is treated as an empty list.
+func listGroupLDAPLinks(ctx context.Context, client *gitlab.Client, group string) ([]*ldapGroupLink, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/ldap_group_links", gitlab.PathEscape(group)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	var links []*ldapGroupLink
+	if _, err := client.Do(req, &links); err != nil {
+		if api.Is404(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return links, nil
+}
+
+func addGroupLDAPLink(ctx context.Context, client *gitlab.Client, group string, options *addGroupLDAPLinkOptions) (*ldapGroupLink, error) {
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/ldap_group_links", gitlab.PathEscape(group)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	var link ldapGroupLink
+	if _, err := client.Do(req, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func deleteGroupLDAPLink(ctx context.Context, client *gitlab.Client, group string, link *ldapGroupLink) error {
+	options := gitlab.DeleteGroupLDAPLinkWithCNOrFilterOptions{
+		Provider: &link.Provider,
+	}
+	if link.CN != "" {
+		options.CN = &link.CN
+	}
+	if link.Filter != "" {
+		options.Filter = &link.Filter
+	}
+
+	_, err := client.Groups.DeleteGroupLDAPLinkWithCNOrFilter(group, &options, gitlab.WithContext(ctx))
+	return err
+}
+
+func memberRoleIDToState(memberRoleID *int) int {
+	if memberRoleID == nil {
+		return 0
+	}
+	return *memberRoleID
+}
+
+func gitlabGroupSAMLLinkSetSchema(computed bool) *schema.Schema {
+	s := &schema.Schema{
+		Description: "The SAML group links of the group.",
+		Type:        schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"saml_group_name": {
+					Description: "The name of the SAML group.",
+					Type:        schema.TypeString,
+					Required:    !computed,
+					Computed:    computed,
+				},
+				"access_level": {
+					Description:      fmt.Sprintf("Access level for members of the SAML group. Valid values are: %s.", utils.RenderValueListForDocs(validGroupSamlLinkAccessLevelNames)),
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validGroupSamlLinkAccessLevelNames, false)),
+					Required:         !computed,
+					Computed:         computed,
+				},
+				"member_role_id": {
+					Description: "The ID of a custom member role for members of the SAML group. Only available for Ultimate instances.",
+					Type:        schema.TypeInt,
+					Optional:    !computed,
+					Computed:    computed,
+				},
+			},
+		},
+	}
+	if computed {
+		s.Computed = true
+		s.Elem.(*schema.Resource).Schema["access_level"].ValidateDiagFunc = nil
+	} else {
+		s.Optional = true
+	}
+	return s
+}
+
+func gitlabGroupLDAPLinkSetSchema(computed bool) *schema.Schema {
+	s := &schema.Schema{
+		Description: "The LDAP group links of the group.",
+		Type:        schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ldap_provider": {
+					Description: "The name of the LDAP provider as stored in the GitLab database, e.g. `ldapmain`.",
+					Type:        schema.TypeString,
+					Required:    !computed,
+					Computed:    computed,
+				},
+				"cn": {
+					Description: "The CN of the LDAP group to link with. Either `cn` or `filter` must be set.",
+					Type:        schema.TypeString,
+					Optional:    !computed,
+					Computed:    computed,
+				},
+				"filter": {
+					Description: "The LDAP filter for the group. Either `cn` or `filter` must be set.",
+					Type:        schema.TypeString,
+					Optional:    !computed,
+					Computed:    computed,
+				},
+				"group_access": {
+					Description:      fmt.Sprintf("Minimum access level for members of the LDAP group. Valid values are: %s", utils.RenderValueListForDocs(api.ValidGroupAccessLevelNames)),
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidGroupAccessLevelNames, false)),
+					Required:         !computed,
+					Computed:         computed,
+				},
+				"member_role_id": {
+					Description: "The ID of a custom member role for members of the LDAP group. Only available for Ultimate instances.",
+					Type:        schema.TypeInt,
+					Optional:    !computed,
+					Computed:    computed,
+				},
+			},
+		},
+	}
+	if computed {
+		s.Computed = true
+		s.Elem.(*schema.Resource).Schema["group_access"].ValidateDiagFunc = nil
+	} else {
+		s.Optional = true
+	}
+	return s
+}
+
+func flattenGitlabGroupSAMLLinks(links []*samlGroupLink) []interface{} {
+	values := []interface{}{}
+	for _, link := range links {
+		values = append(values, map[string]interface{}{
+			"saml_group_name": link.Name,
+			"access_level":    api.AccessLevelValueToName[link.AccessLevel],
+			"member_role_id":  memberRoleIDToState(link.MemberRoleID),
+		})
+	}
+	return values
+}
+
+func flattenGitlabGroupLDAPLinks(links []*ldapGroupLink) []interface{} {
+	values := []interface{}{}
+	for _, link := range links {
+		values = append(values, map[string]interface{}{
+			"ldap_provider":  link.Provider,
+			"cn":             link.CN,
+			"filter":         link.Filter,
+			"group_access":   api.AccessLevelValueToName[link.GroupAccess],
+			"member_role_id": memberRoleIDToState(link.MemberRoleID),
+		})
+	}
+	return values
+}
@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+// scimIdentity is a SCIM identity of a user in a top-level group on GitLab.com.
+//
+// NOTE: go-gitlab doesn't support SCIM identities yet.
+type scimIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    bool   `json:"active"`
+}
+
+type updateScimIdentityOptions struct {
+	ExternUID *string `json:"extern_uid"`
+}
+
+func listGroupScimIdentities(ctx context.Context, client *gitlab.Client, group string) ([]*scimIdentity, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var identities []*scimIdentity
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/scim/identities", gitlab.PathEscape(group)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+
+		var paginatedIdentities []*scimIdentity
+		resp, err := client.Do(req, &paginatedIdentities)
+		if err != nil {
+			return nil, err
+		}
+
+		identities = append(identities, paginatedIdentities...)
+		options.Page = resp.NextPage
+	}
+	return identities, nil
+}
+
+func updateGroupScimIdentity(ctx context.Context, client *gitlab.Client, group string, externUID string, options *updateScimIdentityOptions) error {
+	req, err := client.NewRequest(http.MethodPatch, fmt.Sprintf("groups/%s/scim/%s", gitlab.PathEscape(group), gitlab.PathEscape(externUID)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func gitlabGroupScimIdentitiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The SCIM identities of the group.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"extern_uid": {
+					Description: "The external UID of the user, as provided by the identity provider.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"user_id": {
+					Description: "The id of the GitLab user.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"active": {
+					Description: "Whether the SCIM identity is active.",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenGitlabGroupScimIdentities(identities []*scimIdentity) []interface{} {
+	values := []interface{}{}
+	for _, identity := range identities {
+		values = append(values, map[string]interface{}{
+			"extern_uid": identity.ExternUID,
+			"user_id":    identity.UserID,
+			"active":     identity.Active,
+		})
+	}
+	return values
+}
@@ -0,0 +1,239 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// The invitation resources for groups and projects only differ in the attribute holding the ID of the group or project
+// and the API path, therefore they share the implementation, parameterized by the kind (`group` or `project`).
+
+func gitlabInvitationSchema(kind string, idAttribute string, validAccessLevelNames []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		idAttribute: {
+			Description: fmt.Sprintf("The ID or full path of the %s.", kind),
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+		},
+		"email": {
+			Description: "The email address to invite.",
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
+			},
+		},
+		"access_level": {
+			Description:      fmt.Sprintf("The access level to grant the invited user. Valid values are: %s.", utils.RenderValueListForDocs(validAccessLevelNames)),
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validAccessLevelNames, false)),
+			Required:         true,
+		},
+		"expires_at": {
+			Description:  fmt.Sprintf("Expiration date of the %s membership of the invited user. Format: `YYYY-MM-DD`", kind),
+			Type:         schema.TypeString,
+			ValidateFunc: validateDateFunc,
+			Optional:     true,
+		},
+		"pending": {
+			Description: "Whether the invitation is still pending. It's `false` once the invitation has been accepted or revoked outside of Terraform.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"created_by_name": {
+			Description: "The name of the user who created the invitation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func resourceGitlabInvitationCreate(kind string, idAttribute string) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*gitlab.Client)
+		id := d.Get(idAttribute).(string)
+		email := d.Get("email").(string)
+
+		options := &gitlab.InvitesOptions{
+			Email:       gitlab.String(email),
+			AccessLevel: gitlab.AccessLevel(api.AccessLevelNameToValue[d.Get("access_level").(string)]),
+		}
+		if v, ok := d.GetOk("expires_at"); ok {
+			expiresAt, err := parseISO8601Date(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			options.ExpiresAt = expiresAt
+		}
+
+		log.Printf("[DEBUG] invite %s to gitlab %s %s", email, kind, id)
+		invite := client.Invites.GroupInvites
+		if kind == "project" {
+			invite = client.Invites.ProjectInvites
+		}
+		result, _, err := invite(id, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// NOTE: the API responds with a success status code, even if the invitation failed.
+		if result.Status != "success" {
+			var messages []string
+			for _, message := range result.Message {
+				messages = append(messages, message)
+			}
+			sort.Strings(messages)
+			return diag.Errorf("failed to invite %s to %s %s: %s", email, kind, id, strings.Join(messages, ", "))
+		}
+
+		d.SetId(utils.BuildTwoPartID(&id, &email))
+		return resourceGitlabInvitationRead(kind, idAttribute)(ctx, d, meta)
+	}
+}
+
+func resourceGitlabInvitationRead(kind string, idAttribute string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*gitlab.Client)
+
+		id, email, err := utils.ParseTwoPartID(d.Id())
+		if err != nil {
+			return diag.Errorf("unable to parse %s invitation resource id: %s: %v", kind, d.Id(), err)
+		}
+
+		options := &gitlab.ListPendingInvitationsOptions{
+			ListOptions: gitlab.ListOptions{
+				PerPage: 100,
+				Page:    1,
+			},
+			Query: gitlab.String(email),
+		}
+		listPendingInvitations := client.Invites.ListPendingGroupInvitations
+		if kind == "project" {
+			listPendingInvitations = client.Invites.ListPendingProjectInvitations
+		}
+
+		var invitation *gitlab.PendingInvite
+		for options.Page != 0 && invitation == nil {
+			invitations, resp, err := listPendingInvitations(id, options, gitlab.WithContext(ctx))
+			if err != nil {
+				if api.Is404(err) {
+					log.Printf("[DEBUG] gitlab %s %s not found, removing invitation %s from state", kind, id, email)
+					d.SetId("")
+					return nil
+				}
+				return diag.FromErr(err)
+			}
+
+			for _, i := range invitations {
+				if strings.EqualFold(i.InviteEmail, email) {
+					invitation = i
+					break
+				}
+			}
+			options.Page = resp.NextPage
+		}
+
+		d.Set(idAttribute, id)
+		d.Set("email", email)
+
+		// NOTE: an accepted invitation turns into a membership and can't be distinguished from a revoked one,
+		//       therefore the invitation is kept in the state to not invite the user again.
+		if invitation == nil {
+			log.Printf("[DEBUG] gitlab %s invitation %s for %s is no longer pending", kind, email, id)
+			d.Set("pending", false)
+			return nil
+		}
+
+		d.Set("pending", true)
+		d.Set("access_level", api.AccessLevelValueToName[invitation.AccessLevel])
+		expiresAt := ""
+		if invitation.ExpiresAt != nil {
+			expiresAt = invitation.ExpiresAt.Format("2006-01-02")
+		}
+		d.Set("expires_at", expiresAt)
+		d.Set("created_by_name", invitation.CreatedByName)
+		return nil
+	}
+}
+
+func resourceGitlabInvitationUpdate(kind string, idAttribute string) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*gitlab.Client)
+
+		id, email, err := utils.ParseTwoPartID(d.Id())
+		if err != nil {
+			return diag.Errorf("unable to parse %s invitation resource id: %s: %v", kind, d.Id(), err)
+		}
+
+		if !d.Get("pending").(bool) {
+			return diag.Errorf("the invitation of %s to %s %s is no longer pending and can't be updated, manage the membership instead", email, kind, id)
+		}
+
+		options := &updateInvitationOptions{
+			AccessLevel: gitlab.AccessLevel(api.AccessLevelNameToValue[d.Get("access_level").(string)]),
+			ExpiresAt:   gitlab.String(d.Get("expires_at").(string)),
+		}
+
+		// NOTE: go-gitlab doesn't support updating invitations yet.
+		log.Printf("[DEBUG] update gitlab %s invitation %s for %s", kind, email, id)
+		req, err := client.NewRequest(http.MethodPut, gitlabInvitationPath(kind, id, email), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceGitlabInvitationRead(kind, idAttribute)(ctx, d, meta)
+	}
+}
+
+func resourceGitlabInvitationDelete(kind string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*gitlab.Client)
+
+		id, email, err := utils.ParseTwoPartID(d.Id())
+		if err != nil {
+			return diag.Errorf("unable to parse %s invitation resource id: %s: %v", kind, d.Id(), err)
+		}
+
+		// NOTE: an accepted invitation is a membership now, which is not managed by this resource.
+		if !d.Get("pending").(bool) {
+			log.Printf("[DEBUG] gitlab %s invitation %s for %s is no longer pending, nothing to revoke", kind, email, id)
+			return nil
+		}
+
+		// NOTE: go-gitlab doesn't support revoking invitations yet.
+		log.Printf("[DEBUG] revoke gitlab %s invitation %s for %s", kind, email, id)
+		req, err := client.NewRequest(http.MethodDelete, gitlabInvitationPath(kind, id, email), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := client.Do(req, nil); err != nil && !api.Is404(err) {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func gitlabInvitationPath(kind string, id string, email string) string {
+	return fmt.Sprintf("%ss/%s/invitations/%s", kind, gitlab.PathEscape(id), gitlab.PathEscape(email))
+}
+
+type updateInvitationOptions struct {
+	AccessLevel *gitlab.AccessLevelValue `json:"access_level,omitempty"`
+	ExpiresAt   *string                  `json:"expires_at"`
+}
@@ -0,0 +1,228 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+// updateLabelOptions is used instead of `gitlab.UpdateLabelOptions` and `gitlab.UpdateGroupLabelOptions`,
+// because removing the priority of a label requires to send `null`.
+//
+// NOTE: go-gitlab doesn't support removing the priority of a label yet.
+type updateLabelOptions struct {
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Priority    *int    `json:"priority"`
+}
+
+// updateLabel updates a project label if `kind` is `projects` or a group label if `kind` is `groups`.
+func updateLabel(ctx context.Context, client *gitlab.Client, kind string, id string, options *updateLabelOptions) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s/labels", kind, gitlab.PathEscape(id)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// labelPriorityToOption converts the priority from the state to the API, where `0` means no priority.
+func labelPriorityToOption(priority int) *int {
+	if priority == 0 {
+		return nil
+	}
+	return gitlab.Int(priority)
+}
+
+func gitlabLabelPrioritySchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "The priority of the label. Labels with a lower value have a higher priority. A value of `0` means that the label isn't prioritized, because it can't be distinguished from an unset priority.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// gitlabLabelSetSchema returns the schema of the labels of the authoritative `gitlab_project_labels` and `gitlab_group_labels` resources.
+func gitlabLabelSetSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The labels. Labels which are not declared are deleted.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the label.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"color": {
+					Description: "The color of the label given in 6-digit hex notation with leading '#' sign (e.g. #FFAABB) or one of the [CSS color names](https://developer.mozilla.org/en-US/docs/Web/CSS/color_value#Color_keywords).",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"description": {
+					Description: "The description of the label.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"priority": gitlabLabelPrioritySchema(),
+			},
+		},
+	}
+}
+
+// gitlabLabelsDataSourceSchema returns the schema of the labels of the `gitlab_project_labels` and `gitlab_group_labels` data sources.
+func gitlabLabelsDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The labels.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label_id": {
+					Description: "The id of the label.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"name": {
+					Description: "The name of the label.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"color": {
+					Description: "The color of the label.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"text_color": {
+					Description: "The text color of the label.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"description": {
+					Description: "The description of the label.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"priority": {
+					Description: "The priority of the label. A value of `0` means that the label isn't prioritized.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"is_project_label": {
+					Description: "Whether the label is a project label and not inherited from a group.",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenGitlabLabels(labels []*gitlab.Label) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(labels))
+	for _, label := range labels {
+		values = append(values, map[string]interface{}{
+			"label_id":         label.ID,
+			"name":             label.Name,
+			"color":            label.Color,
+			"text_color":       label.TextColor,
+			"description":      label.Description,
+			"priority":         label.Priority,
+			"is_project_label": label.IsProjectLabel,
+		})
+	}
+	return values
+}
+
+// flattenGitlabLabelSet flattens the labels for the authoritative `gitlab_project_labels` and `gitlab_group_labels` resources.
+func flattenGitlabLabelSet(labels []*gitlab.Label) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(labels))
+	for _, label := range labels {
+		values = append(values, map[string]interface{}{
+			"name":        label.Name,
+			"color":       label.Color,
+			"description": label.Description,
+			"priority":    label.Priority,
+		})
+	}
+	return values
+}
+
+// expandGitlabLabelSet expands the labels of the authoritative `gitlab_project_labels` and `gitlab_group_labels` resources, keyed by name.
+func expandGitlabLabelSet(labels *schema.Set) (map[string]*gitlab.Label, error) {
+	result := make(map[string]*gitlab.Label)
+	for _, v := range labels.List() {
+		m := v.(map[string]interface{})
+		label := &gitlab.Label{
+			Name:        m["name"].(string),
+			Color:       m["color"].(string),
+			Description: m["description"].(string),
+			Priority:    m["priority"].(int),
+		}
+		if _, ok := result[label.Name]; ok {
+			return nil, fmt.Errorf("label %q is declared more than once", label.Name)
+		}
+		result[label.Name] = label
+	}
+	return result, nil
+}
+
+// listProjectLabels lists the labels of the project, optionally including the labels of its ancestor groups.
+func listProjectLabels(ctx context.Context, client *gitlab.Client, project string, includeAncestorGroups bool, search string) ([]*gitlab.Label, error) {
+	options := &gitlab.ListLabelsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeAncestorGroups: gitlab.Bool(includeAncestorGroups),
+	}
+	if search != "" {
+		options.Search = gitlab.String(search)
+	}
+
+	var labels []*gitlab.Label
+	for options.Page != 0 {
+		paginatedLabels, resp, err := client.Labels.ListLabels(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, paginatedLabels...)
+		options.Page = resp.NextPage
+	}
+	return labels, nil
+}
+
+// listGroupLabels lists the labels of the group, optionally including the labels of its ancestor groups.
+func listGroupLabels(ctx context.Context, client *gitlab.Client, group string, includeAncestorGroups bool, search string) ([]*gitlab.Label, error) {
+	options := &gitlab.ListGroupLabelsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeAncestorGroups: gitlab.Bool(includeAncestorGroups),
+		OnlyGroupLabels:       gitlab.Bool(true),
+	}
+	if search != "" {
+		options.Search = gitlab.String(search)
+	}
+
+	var labels []*gitlab.Label
+	for options.Page != 0 {
+		paginatedLabels, resp, err := client.GroupLabels.ListGroupLabels(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, label := range paginatedLabels {
+			labels = append(labels, (*gitlab.Label)(label))
+		}
+		options.Page = resp.NextPage
+	}
+	return labels, nil
+}
@@ -0,0 +1,252 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// accessTokenBotUsernameRegex matches the usernames of the bot users GitLab creates for project and group access tokens.
+var accessTokenBotUsernameRegex = regexp.MustCompile(`^(project|group)_\d+_bot(_[0-9a-f]+)?$`)
+
+func gitlabMembersSchema(namespaceKind string, validAccessLevelNames []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"members": {
+			Description: fmt.Sprintf("The complete list of members of the %[1]s. Direct members of the %[1]s which are not in this list are removed.", namespaceKind),
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Description: "The id of the user.",
+						Type:        schema.TypeInt,
+						Required:    true,
+					},
+					"access_level": {
+						Description:      fmt.Sprintf("The access level for the member. Valid values are: %s.", utils.RenderValueListForDocs(validAccessLevelNames)),
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validAccessLevelNames, false)),
+						Required:         true,
+					},
+					"expires_at": {
+						Description:  fmt.Sprintf("Expiration date for the %s membership. Format: `YYYY-MM-DD`", namespaceKind),
+						Type:         schema.TypeString,
+						ValidateFunc: validateDateFunc,
+						Optional:     true,
+					},
+				},
+			},
+		},
+		"ignore_inherited_members": {
+			Description: fmt.Sprintf("Ignore the members inherited from parent groups. If `false`, the inherited members are part of the member list as well and must be declared with their inherited access level, because they can't be removed from the %s. Defaults to `false`.", namespaceKind),
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"ignore_bot_members": {
+			Description: "Ignore the bot users of project and group access tokens. They are neither read into the member list nor removed. Defaults to `false`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+// gitlabMember is the common representation of a group or project member.
+type gitlabMember struct {
+	UserID      int
+	Username    string
+	AccessLevel gitlab.AccessLevelValue
+	ExpiresAt   string
+}
+
+func (m *gitlabMember) isAccessTokenBot() bool {
+	return accessTokenBotUsernameRegex.MatchString(m.Username)
+}
+
+func (m *gitlabMember) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":      m.UserID,
+		"access_level": api.AccessLevelValueToName[m.AccessLevel],
+		"expires_at":   m.ExpiresAt,
+	}
+}
+
+func isoTimeToString(t *gitlab.ISOTime) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+// gitlabMembersClient abstracts the group and project member APIs,
+// so that the authoritative member resources share the same behavior.
+type gitlabMembersClient struct {
+	// listDirect lists the direct members.
+	listDirect func() ([]*gitlabMember, error)
+	// listAll lists the direct and the inherited members.
+	listAll func() ([]*gitlabMember, error)
+	add     func(member *gitlabMember) error
+	edit    func(member *gitlabMember) error
+	remove  func(member *gitlabMember) error
+}
+
+// gitlabMembersCurrent returns the direct members and the inherited members which aren't direct members at the same time.
+// The inherited members are only listed if they aren't ignored.
+func gitlabMembersCurrent(d *schema.ResourceData, c *gitlabMembersClient) (map[int]*gitlabMember, map[int]*gitlabMember, error) {
+	direct := map[int]*gitlabMember{}
+	members, err := c.listDirect()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range members {
+		direct[m.UserID] = m
+	}
+
+	inherited := map[int]*gitlabMember{}
+	if !d.Get("ignore_inherited_members").(bool) {
+		members, err := c.listAll()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, m := range members {
+			if _, ok := direct[m.UserID]; !ok {
+				inherited[m.UserID] = m
+			}
+		}
+	}
+
+	return direct, inherited, nil
+}
+
+// gitlabMembersRead returns the member list to store in the state.
+func gitlabMembersRead(d *schema.ResourceData, c *gitlabMembersClient) ([]interface{}, error) {
+	direct, inherited, err := gitlabMembersCurrent(d, c)
+	if err != nil {
+		return nil, err
+	}
+
+	ignoreBots := d.Get("ignore_bot_members").(bool)
+	var members []*gitlabMember
+	for _, m := range direct {
+		members = append(members, m)
+	}
+	for _, m := range inherited {
+		members = append(members, m)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].UserID < members[j].UserID })
+
+	var result []interface{}
+	for _, m := range members {
+		if ignoreBots && m.isAccessTokenBot() {
+			continue
+		}
+		result = append(result, m.toMap())
+	}
+	return result, nil
+}
+
+// gitlabMembersApply makes the member list match the configured members.
+// Members are added and updated before any member is removed, so that a group or project never ends up without owner.
+func gitlabMembersApply(d *schema.ResourceData, c *gitlabMembersClient) error {
+	direct, inherited, err := gitlabMembersCurrent(d, c)
+	if err != nil {
+		return err
+	}
+
+	desired := map[int]*gitlabMember{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		member := &gitlabMember{
+			UserID:      m["user_id"].(int),
+			AccessLevel: api.AccessLevelNameToValue[m["access_level"].(string)],
+			ExpiresAt:   m["expires_at"].(string),
+		}
+		if _, ok := desired[member.UserID]; ok {
+			return fmt.Errorf("user %d is declared more than once in the member list", member.UserID)
+		}
+		desired[member.UserID] = member
+	}
+
+	// NOTE: the member list is validated before any change is made, so that an invalid list doesn't leave the members half-applied.
+	for userID, member := range inherited {
+		if _, ok := desired[userID]; !ok && !(d.Get("ignore_bot_members").(bool) && member.isAccessTokenBot()) {
+			return fmt.Errorf("member %s (%d) is inherited from a parent group and can't be removed, remove it from the parent group, declare it in the member list or set `ignore_inherited_members` to `true`", member.Username, userID)
+		}
+	}
+
+	for userID, member := range desired {
+		if existing, ok := direct[userID]; ok {
+			if existing.AccessLevel != member.AccessLevel || existing.ExpiresAt != member.ExpiresAt {
+				log.Printf("[DEBUG] update member %d", userID)
+				if err := c.edit(member); err != nil {
+					return fmt.Errorf("failed to update member %d: %w", userID, err)
+				}
+			}
+			continue
+		}
+
+		// NOTE: an inherited member with the declared access level doesn't need a direct membership.
+		if existing, ok := inherited[userID]; ok && existing.AccessLevel == member.AccessLevel && existing.ExpiresAt == member.ExpiresAt {
+			continue
+		}
+
+		log.Printf("[DEBUG] add member %d", userID)
+		if err := c.add(member); err != nil {
+			return fmt.Errorf("failed to add member %d: %w", userID, err)
+		}
+	}
+
+	for userID, member := range direct {
+		if _, ok := desired[userID]; ok {
+			continue
+		}
+		if d.Get("ignore_bot_members").(bool) && member.isAccessTokenBot() {
+			continue
+		}
+
+		log.Printf("[DEBUG] remove unmanaged member %s (%d)", member.Username, userID)
+		if err := c.remove(member); err != nil && !api.Is404(err) {
+			return fmt.Errorf("failed to remove member %d: %w", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// gitlabMembersDelete removes the direct members in the state, except for the current user, to not lose access.
+func gitlabMembersDelete(d *schema.ResourceData, c *gitlabMembersClient, currentUserID int) error {
+	direct, err := c.listDirect()
+	if err != nil {
+		return err
+	}
+
+	managed := map[int]bool{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		managed[v.(map[string]interface{})["user_id"].(int)] = true
+	}
+
+	for _, member := range direct {
+		if !managed[member.UserID] {
+			continue
+		}
+		if member.UserID == currentUserID {
+			log.Printf("[DEBUG] skip removing current user %d from members", currentUserID)
+			continue
+		}
+
+		log.Printf("[DEBUG] remove member %s (%d)", member.Username, member.UserID)
+		if err := c.remove(member); err != nil && !api.Is404(err) {
+			return fmt.Errorf("failed to remove member %d: %w", member.UserID, err)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"fmt"
+	"log"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,13 +11,22 @@ import (
 )
 
 func gitlabProjectIssueBoardSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"project": {
-			Description: "The ID or full path of the project maintained by the authenticated user.",
-			Type:        schema.TypeString,
-			ForceNew:    true,
-			Required:    true,
+	return constructSchema(
+		map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project maintained by the authenticated user.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
 		},
+		gitlabIssueBoardSchema(),
+	)
+}
+
+// gitlabIssueBoardSchema returns the schema shared by project and group issue boards.
+func gitlabIssueBoardSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
 		"name": {
 			Description: "The name of the board.",
 			Type:        schema.TypeString,
@@ -43,63 +54,90 @@ func gitlabProjectIssueBoardSchema() map[string]*schema.Schema {
 			Optional:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 9)),
 		},
-		"lists": {
-			Description: "The list of issue board lists",
-			Type:        schema.TypeList,
-			Optional:    true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"id": {
-						Description: "The ID of the list",
-						Type:        schema.TypeInt,
-						Computed:    true,
-					},
-					"label_id": {
-						Description: "The ID of the label the list should be scoped to. Requires a GitLab EE license.",
-						Type:        schema.TypeInt,
-						Optional:    true,
-						// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
-						//           Anyways, GitLab will complain about this, so no big deal ...
-						// ConflictsWith: []string{"lists.assignee_id", "lists.milestone_id", "lists.iteration_id"},
-					},
-					"assignee_id": {
-						Description: "The ID of the assignee the list should be scoped to. Requires a GitLab EE license.",
-						Type:        schema.TypeInt,
-						Optional:    true,
-						// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
-						//           Anyways, GitLab will complain about this, so no big deal ...
-						// ConflictsWith: []string{"lists.label_id", "lists.milestone_id", "lists.iteration_id"},
-					},
-					"milestone_id": {
-						Description: "The ID of the milestone the list should be scoped to. Requires a GitLab EE license.",
-						Type:        schema.TypeInt,
-						Optional:    true,
-						// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
-						//           Anyways, GitLab will complain about this, so no big deal ...
-						// ConflictsWith: []string{"lists.label_id", "lists.assignee_id", "lists.iteration_id"},
-					},
-					"iteration_id": {
-						Description: "The ID of the iteration the list should be scoped to. Requires a GitLab EE license.",
-						Type:        schema.TypeInt,
-						Optional:    true,
-						// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
-						//           Anyways, GitLab will complain about this, so no big deal ...
-						// ConflictsWith: []string{"lists.label_id", "lists.assignee_id", "lists.milestone_id"},
-					},
-					"position": {
-						Description: "The position of the list within the board. The position for the list is based on the its position in the `lists` array.",
-						Type:        schema.TypeInt,
-						Computed:    true,
-					},
+		"lists": gitlabIssueBoardListsSchema(),
+	}
+}
+
+// gitlabIssueBoardListsSchema returns the schema of the lists of project and group issue boards.
+func gitlabIssueBoardListsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The list of issue board lists",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The ID of the list",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"label_id": {
+					Description: "The ID of the label the list should be scoped to. Requires a GitLab EE license.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
+					//           Anyways, GitLab will complain about this, so no big deal ...
+					// ConflictsWith: []string{"lists.assignee_id", "lists.milestone_id", "lists.iteration_id"},
+				},
+				"assignee_id": {
+					Description: "The ID of the assignee the list should be scoped to. Requires a GitLab EE license.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
+					//           Anyways, GitLab will complain about this, so no big deal ...
+					// ConflictsWith: []string{"lists.label_id", "lists.milestone_id", "lists.iteration_id"},
+				},
+				"milestone_id": {
+					Description: "The ID of the milestone the list should be scoped to. Requires a GitLab EE license.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
+					//           Anyways, GitLab will complain about this, so no big deal ...
+					// ConflictsWith: []string{"lists.label_id", "lists.assignee_id", "lists.iteration_id"},
+				},
+				"iteration_id": {
+					Description: "The ID of the iteration the list should be scoped to. Requires a GitLab EE license.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					// NOTE(TF): not supported by the SDK yet, see https://github.com/hashicorp/terraform-plugin-sdk/issues/71
+					//           Anyways, GitLab will complain about this, so no big deal ...
+					// ConflictsWith: []string{"lists.label_id", "lists.assignee_id", "lists.milestone_id"},
+				},
+				"position": {
+					Description: "The position of the list within the board. The position for the list is based on the its position in the `lists` array.",
+					Type:        schema.TypeInt,
+					Computed:    true,
 				},
 			},
 		},
 	}
 }
 
+// gitlabEpicBoardListsSchema returns the schema of the lists of group epic boards, which can only be scoped to labels.
+func gitlabEpicBoardListsSchema() *schema.Schema {
+	listsSchema := gitlabIssueBoardListsSchema()
+	listsSchema.Description = "The list of epic board lists"
+	listSchema := listsSchema.Elem.(*schema.Resource).Schema
+	delete(listSchema, "assignee_id")
+	delete(listSchema, "milestone_id")
+	delete(listSchema, "iteration_id")
+	listSchema["label_id"] = &schema.Schema{
+		Description: "The ID of the label the list should be scoped to.",
+		Type:        schema.TypeInt,
+		Required:    true,
+	}
+	return listsSchema
+}
+
 func gitlabProjectIssueBoardToStateMap(project string, issueBoard *gitlab.IssueBoard) map[string]interface{} {
-	stateMap := make(map[string]interface{})
+	stateMap := gitlabIssueBoardToStateMap(issueBoard)
 	stateMap["project"] = project
+	return stateMap
+}
+
+// gitlabIssueBoardToStateMap returns the state of the attributes shared by project and group issue boards.
+func gitlabIssueBoardToStateMap(issueBoard *gitlab.IssueBoard) map[string]interface{} {
+	stateMap := make(map[string]interface{})
 	stateMap["name"] = issueBoard.Name
 	if issueBoard.Milestone != nil {
 		stateMap["milestone_id"] = issueBoard.Milestone.ID
@@ -113,11 +151,11 @@ func gitlabProjectIssueBoardToStateMap(project string, issueBoard *gitlab.IssueB
 	}
 	stateMap["weight"] = issueBoard.Weight
 	stateMap["labels"] = extractLabelNames(issueBoard.Labels)
-	stateMap["lists"] = flattenProjectIssueBoardLists(issueBoard.Lists)
+	stateMap["lists"] = flattenBoardLists(issueBoard.Lists)
 	return stateMap
 }
 
-func flattenProjectIssueBoardLists(lists []*gitlab.BoardList) (values []map[string]interface{}) {
+func flattenBoardLists(lists []*gitlab.BoardList) (values []map[string]interface{}) {
 	// GitLab returns the lists in arbitrary order, so we need to sort them by position first
 	sort.Slice(lists, func(i, j int) bool {
 		return lists[i].Position < lists[j].Position
@@ -161,3 +199,149 @@ func extractLabelNames(labels []*gitlab.LabelDetails) []string {
 	}
 	return labelNames
 }
+
+// boardListScope identifies a board list, because the scope of a list can't be changed.
+type boardListScope struct {
+	LabelID     int
+	AssigneeID  int
+	MilestoneID int
+	IterationID int
+}
+
+func boardListScopeFromList(list *gitlab.BoardList) boardListScope {
+	var scope boardListScope
+	if list.Label != nil {
+		scope.LabelID = list.Label.ID
+	}
+	if list.Assignee != nil {
+		scope.AssigneeID = list.Assignee.ID
+	}
+	if list.Milestone != nil {
+		scope.MilestoneID = list.Milestone.ID
+	}
+	if list.Iteration != nil {
+		scope.IterationID = list.Iteration.ID
+	}
+	return scope
+}
+
+func boardListScopeFromState(listData interface{}) boardListScope {
+	var scope boardListScope
+	if listData == nil {
+		return scope
+	}
+	l := listData.(map[string]interface{})
+	if v, ok := l["label_id"]; ok {
+		scope.LabelID = v.(int)
+	}
+	if v, ok := l["assignee_id"]; ok {
+		scope.AssigneeID = v.(int)
+	}
+	if v, ok := l["milestone_id"]; ok {
+		scope.MilestoneID = v.(int)
+	}
+	if v, ok := l["iteration_id"]; ok {
+		scope.IterationID = v.(int)
+	}
+	return scope
+}
+
+func (s boardListScope) String() string {
+	return fmt.Sprintf("label_id=%d, assignee_id=%d, milestone_id=%d, iteration_id=%d", s.LabelID, s.AssigneeID, s.MilestoneID, s.IterationID)
+}
+
+func (s boardListScope) createIssueBoardListOptions() *gitlab.CreateIssueBoardListOptions {
+	options := &gitlab.CreateIssueBoardListOptions{}
+	if s.LabelID != 0 {
+		options.LabelID = gitlab.Int(s.LabelID)
+	}
+	if s.AssigneeID != 0 {
+		options.AssigneeID = gitlab.Int(s.AssigneeID)
+	}
+	if s.MilestoneID != 0 {
+		options.MilestoneID = gitlab.Int(s.MilestoneID)
+	}
+	if s.IterationID != 0 {
+		options.IterationID = gitlab.Int(s.IterationID)
+	}
+	return options
+}
+
+// boardListOperations are the API calls to manage the lists of a project issue board, group issue board or group epic board.
+type boardListOperations struct {
+	create func(scope boardListScope) (int, error)
+	move   func(listID int, position int) error
+	delete func(listID int) error
+}
+
+// updateBoardLists updates the lists of a board in-place, so that existing lists keep their IDs:
+// lists which are not declared anymore are deleted, missing lists are created
+// and all lists are moved to the position of their declaration.
+func updateBoardLists(current []*gitlab.BoardList, lists []interface{}, ops boardListOperations) error {
+	desired := make([]boardListScope, 0, len(lists))
+	declared := make(map[boardListScope]bool, len(lists))
+	for _, listData := range lists {
+		scope := boardListScopeFromState(listData)
+		if declared[scope] {
+			return fmt.Errorf("list with %s is declared more than once", scope)
+		}
+		declared[scope] = true
+		desired = append(desired, scope)
+	}
+
+	sort.Slice(current, func(i, j int) bool {
+		return current[i].Position < current[j].Position
+	})
+
+	existing := make(map[boardListScope]int, len(current))
+	// order contains the IDs of the lists in the order of their positions on the board.
+	var order []int
+	for _, list := range current {
+		scope := boardListScopeFromList(list)
+		if !declared[scope] {
+			log.Printf("[DEBUG] deleting list %d with %s", list.ID, scope)
+			if err := ops.delete(list.ID); err != nil {
+				return fmt.Errorf("failed to delete list %d with %s: %w", list.ID, scope, err)
+			}
+			continue
+		}
+		existing[scope] = list.ID
+		order = append(order, list.ID)
+	}
+
+	for _, scope := range desired {
+		if _, ok := existing[scope]; ok {
+			continue
+		}
+		log.Printf("[DEBUG] creating list with %s", scope)
+		listID, err := ops.create(scope)
+		if err != nil {
+			return fmt.Errorf("failed to create list with %s: %w", scope, err)
+		}
+		existing[scope] = listID
+		order = append(order, listID)
+	}
+
+	for position, scope := range desired {
+		listID := existing[scope]
+		if order[position] == listID {
+			continue
+		}
+
+		log.Printf("[DEBUG] moving list %d with %s to position %d", listID, scope, position)
+		if err := ops.move(listID, position); err != nil {
+			return fmt.Errorf("failed to move list %d with %s to position %d: %w", listID, scope, position, err)
+		}
+
+		// Moving a list shifts the lists between its previous and its new position.
+		for i := position + 1; i < len(order); i++ {
+			if order[i] == listID {
+				copy(order[position+1:i+1], order[position:i])
+				order[position] = listID
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// gitlabUserTokenSchema returns the schema shared by the tokens of a user, like personal access tokens and impersonation tokens.
+func gitlabUserTokenSchema(tokenName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Description: "The id of the user.",
+			Type:        schema.TypeInt,
+			ForceNew:    true,
+			Required:    true,
+		},
+		"name": {
+			Description: fmt.Sprintf("The name of the %s.", tokenName),
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"scopes": {
+			Description: fmt.Sprintf("The scope for the %s. It determines the actions which can be performed when authenticating with this token. Valid values are: %s.", tokenName, utils.RenderValueListForDocs(validPersonalAccessTokenScopes)),
+			Type:        schema.TypeSet,
+			Required:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(validPersonalAccessTokenScopes, false),
+			},
+		},
+		"active": {
+			Description: "True if the token is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"revoked": {
+			Description: "True if the token is revoked.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "Time the token has been created, RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_at": {
+			Description:      "The token expires at midnight UTC on that date. The date must be in the format YYYY-MM-DD.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Computed:         true,
+			ValidateDiagFunc: isISO6801Date,
+		},
+		"token": {
+			Description: fmt.Sprintf("The %s. This is only populated when creating a new %s. This attribute is not available for imported resources.", tokenName, tokenName),
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
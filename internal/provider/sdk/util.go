@@ -51,6 +51,32 @@ var validateURLFunc = func(v interface{}, k string) (s []string, errors []error)
 	return
 }
 
+// validateProtectedBranchNameFunc validates the name or wildcard pattern of a protected branch
+// according to the git rules for reference names, allowing `*` as a wildcard.
+var validateProtectedBranchNameFunc = func(i interface{}, k string) ([]string, []error) {
+	name, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	switch {
+	case name == "":
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	case strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || strings.HasSuffix(name, "."):
+		return nil, []error{fmt.Errorf("%q must not start or end with `/` or end with `.`, got %q", k, name)}
+	case strings.Contains(name, "..") || strings.Contains(name, "//") || strings.Contains(name, "@{"):
+		return nil, []error{fmt.Errorf("%q must not contain `..`, `//` or `@{`, got %q", k, name)}
+	case strings.ContainsAny(name, " ~^:?[\\"):
+		return nil, []error{fmt.Errorf("%q must not contain spaces or any of `~^:?[\\`, got %q", k, name)}
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return nil, []error{fmt.Errorf("%q must not contain path components starting with `.` or ending with `.lock`, got %q", k, name)}
+		}
+	}
+	return nil, nil
+}
+
 func stringToVisibilityLevel(s string) *gitlab.VisibilityValue {
 	lookup := map[string]gitlab.VisibilityValue{
 		"private":  gitlab.PrivateVisibility,
@@ -400,27 +426,3 @@ func setStateMapInResourceData(stateMap map[string]interface{}, d *schema.Resour
 
 	return nil
 }
-
-// lock can be used to lock, but make it `context.Context` aware.
-// e.g. it'll respect cancelling and timeouts.
-type lock chan struct{}
-
-func newLock() lock {
-	return make(lock, 1)
-
-}
-
-func (c lock) lock(ctx context.Context) error {
-	select {
-	case c <- struct{}{}:
-		// lock acquired
-		return nil
-	case <-ctx.Done():
-		// Timeout
-		return ctx.Err()
-	}
-}
-
-func (c lock) unlock() {
-	<-c
-}
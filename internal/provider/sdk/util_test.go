@@ -119,3 +119,32 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateProtectedBranchNameFunc(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "main", ErrCount: 0},
+		{Value: "release/*", ErrCount: 0},
+		{Value: "*-stable", ErrCount: 0},
+		{Value: "feature/*/hotfix", ErrCount: 0},
+		{Value: "", ErrCount: 1},
+		{Value: "/main", ErrCount: 1},
+		{Value: "release/", ErrCount: 1},
+		{Value: "release//*", ErrCount: 1},
+		{Value: "release..*", ErrCount: 1},
+		{Value: "release *", ErrCount: 1},
+		{Value: "release?", ErrCount: 1},
+		{Value: "release/.hidden", ErrCount: 1},
+		{Value: "main.lock", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateProtectedBranchNameFunc(tc.Value, "branch")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// readSecurityPolicyProject returns the security policy project linked to the project or group with the given full path.
+// `namespaceType` is either `project` or `group`. If no security policy project is linked, nil is returned.
+func readSecurityPolicyProject(ctx context.Context, client *gitlab.Client, namespaceType string, fullPath string) (*graphQLSecurityPolicyProject, error) {
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			query {
+				%s(fullPath: "%s") {
+					securityPolicyProject {
+						id,
+						fullPath
+					}
+				}
+			}`, namespaceType, fullPath),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to retrieve security policy project", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response securityPolicyProjectResponse
+	if _, err := api.SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return nil, err
+	}
+
+	if namespaceType == "group" {
+		return response.Data.Group.SecurityPolicyProject, nil
+	}
+	return response.Data.Project.SecurityPolicyProject, nil
+}
+
+// assignSecurityPolicyProject links the security policy project to the project or group with the given full path.
+// An already linked security policy project is replaced.
+func assignSecurityPolicyProject(ctx context.Context, client *gitlab.Client, fullPath string, policyProjectID int) error {
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			mutation {
+				securityPolicyProjectAssign(
+					input: {
+						fullPath: "%s",
+						securityPolicyProjectId: "gid://gitlab/Project/%d"
+					}
+				) {
+					errors
+				}
+			}`, fullPath, policyProjectID),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to assign security policy project", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response securityPolicyProjectMutationResponse
+	if _, err := api.SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.SecurityPolicyProjectAssign.Errors; len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// unassignSecurityPolicyProject removes the link to the security policy project from the project or group with the given full path.
+func unassignSecurityPolicyProject(ctx context.Context, client *gitlab.Client, fullPath string) error {
+	query := api.GraphQLQuery{
+		Query: fmt.Sprintf(`
+			mutation {
+				securityPolicyProjectUnassign(
+					input: {
+						fullPath: "%s"
+					}
+				) {
+					errors
+				}
+			}`, fullPath),
+	}
+	tflog.Debug(ctx, "executing GraphQL Query to unassign security policy project", map[string]interface{}{
+		"query": query.Query,
+	})
+
+	var response securityPolicyProjectMutationResponse
+	if _, err := api.SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.SecurityPolicyProjectUnassign.Errors; len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// securityPolicyProjectMatches returns true if the configured policy project, given as ID or full path,
+// refers to the linked security policy project.
+func securityPolicyProjectMatches(configured string, linked *graphQLSecurityPolicyProject) bool {
+	if linked == nil {
+		return false
+	}
+	return configured == linked.FullPath || fmt.Sprintf("gid://gitlab/Project/%s", configured) == linked.ID
+}
+
+type securityPolicyProjectResponse struct {
+	Data struct {
+		Project struct {
+			SecurityPolicyProject *graphQLSecurityPolicyProject `json:"securityPolicyProject"`
+		} `json:"project"`
+		Group struct {
+			SecurityPolicyProject *graphQLSecurityPolicyProject `json:"securityPolicyProject"`
+		} `json:"group"`
+	} `json:"data"`
+}
+
+type securityPolicyProjectMutationResponse struct {
+	Data struct {
+		SecurityPolicyProjectAssign struct {
+			Errors []string `json:"errors"`
+		} `json:"securityPolicyProjectAssign"`
+		SecurityPolicyProjectUnassign struct {
+			Errors []string `json:"errors"`
+		} `json:"securityPolicyProjectUnassign"`
+	} `json:"data"`
+}
+
+type graphQLSecurityPolicyProject struct {
+	ID       string `json:"id"` // This comes back as a globally unique ID
+	FullPath string `json:"fullPath"`
+}
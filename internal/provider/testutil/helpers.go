@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strings"
 	"testing"
 	"time"
 
@@ -55,26 +54,12 @@ func IsRunningInEE() (bool, error) {
 	if isEE != nil {
 		return *isEE, nil
 	}
-	metadata, _, err := TestGitlabClient.Metadata.GetMetadata()
+	enterprise, err := api.IsGitLabEnterprise(context.Background(), TestGitlabClient)
 	if err != nil {
 		return false, err
 	}
-	isEE = gitlab.Bool(isEnterpriseInstance(metadata))
-	return *isEE, err
-}
-
-// isEnterpriseInstance is an auxiliary func so that we can skip
-// TestGitlabClient.Metadata.GetMetadata server calls and unit test it.
-func isEnterpriseInstance(metadata *gitlab.Metadata) bool {
-	if metadata.Enterprise {
-		return true
-	}
-	// This is only to support 15.5. From 15.8 on, we can remove this code
-	// as we won't be supporting 15.5 anymore.
-	if strings.Contains(metadata.Version, "-ee") {
-		return true
-	}
-	return false
+	isEE = gitlab.Bool(enterprise)
+	return *isEE, nil
 }
 
 // IsRunningInCE returns true if the acceptance test is running Gitlab CE.
@@ -533,6 +518,32 @@ func CreateProjectLabels(t *testing.T, pid interface{}, n int) []*gitlab.Label {
 	return labels
 }
 
+func CreateGroupIssueBoard(t *testing.T, gid interface{}) *gitlab.GroupIssueBoard {
+	t.Helper()
+
+	issueBoard, _, err := TestGitlabClient.GroupIssueBoards.CreateGroupIssueBoard(gid, &gitlab.CreateGroupIssueBoardOptions{Name: gitlab.String(acctest.RandomWithPrefix("acctest"))})
+	if err != nil {
+		t.Fatalf("could not create test group issue board: %v", err)
+	}
+
+	return issueBoard
+}
+
+func CreateGroupLabels(t *testing.T, gid interface{}, n int) []*gitlab.GroupLabel {
+	t.Helper()
+
+	var labels []*gitlab.GroupLabel
+	for i := 0; i < n; i++ {
+		label, _, err := TestGitlabClient.GroupLabels.CreateGroupLabel(gid, &gitlab.CreateGroupLabelOptions{Name: gitlab.String(acctest.RandomWithPrefix("acctest")), Color: gitlab.String("#000000")})
+		if err != nil {
+			t.Fatalf("could not create test group label: %v", err)
+		}
+		labels = append(labels, label)
+	}
+
+	return labels
+}
+
 // AddGroupMembers is a test helper for adding users as members of a group.
 // It assumes the group will be destroyed at the end of the test and will not cleanup members.
 func AddGroupMembers(t *testing.T, gid interface{}, users []*gitlab.User) {
@@ -4,8 +4,11 @@
 package testutil
 
 import (
-	"github.com/xanzy/go-gitlab"
 	"testing"
+
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 func TestIsRunningInEE(t *testing.T) {
@@ -39,7 +42,7 @@ func TestIsRunningInEE(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		result := isEnterpriseInstance(tc.metadata)
+		result := api.IsEnterpriseMetadata(tc.metadata)
 		if result != tc.expectedResult {
 			t.Fatalf("\"IsRunningInEE()\" FAILED, expected -> %v, got -> %v", tc.expectedResult, result)
 		}
@@ -7,3 +7,6 @@ import (
 
 // HttpUrlValidator validates that URL starts with http or https schema
 var HttpUrlValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "value should be an URL with http or https schema")
+
+// RFC3339Validator validates that the value is a timestamp in the RFC3339 format
+var RFC3339Validator = stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`), "value should be a timestamp in the RFC3339 format, e.g. `2023-01-01T00:00:00Z`")